@@ -0,0 +1,97 @@
+package govfx
+
+import "sync"
+
+//==============================================================================
+
+// Animation defines a handle to a running animation, exposing the elements
+// and properties it animates alongside its progress and state.
+type Animation interface {
+	Elements() []*ElementAnimation
+	Properties() map[Elemental][]string
+	Frame() Frame
+	State() AnimationState
+
+	Pause()
+	Resume()
+	Stop()
+}
+
+// active tracks the started timelines until they end or are stopped.
+var active activeTimelines
+
+// activeTimelines defines the set of timelines which have started and not yet
+// ended, dropping the ended timelines as the set changes or is listed.
+type activeTimelines struct {
+	ml        sync.Mutex
+	timelines []*Timeline
+}
+
+// Add adds the timeline into the set.
+func (a *activeTimelines) Add(t *Timeline) {
+	a.ml.Lock()
+	defer a.ml.Unlock()
+
+	a.timelines = append(a.prune(), t)
+}
+
+// List returns the timelines of the set which have not ended.
+func (a *activeTimelines) List() []*Timeline {
+	a.ml.Lock()
+	defer a.ml.Unlock()
+
+	a.timelines = a.prune()
+	return append([]*Timeline(nil), a.timelines...)
+}
+
+// prune returns the timelines of the set which have not ended, the set must be
+// locked by the caller.
+func (a *activeTimelines) prune() []*Timeline {
+	var alive []*Timeline
+
+	for _, t := range a.timelines {
+		if !t.ended() && !containsTimeline(alive, t) {
+			alive = append(alive, t)
+		}
+	}
+
+	return alive
+}
+
+//==============================================================================
+
+// ActiveAnimations returns the animations which have started, including those
+// within their delay or paused, and have neither ended nor been stopped.
+func ActiveAnimations() []Animation {
+	timelines := active.List()
+
+	animations := make([]Animation, len(timelines))
+	for index, t := range timelines {
+		animations[index] = t
+	}
+
+	return animations
+}
+
+// PauseAll pauses all active animations.
+func PauseAll() {
+	for _, t := range active.List() {
+		t.Pause()
+	}
+}
+
+// ResumeAll resumes all paused active animations.
+func ResumeAll() {
+	for _, t := range active.List() {
+		t.Resume()
+	}
+}
+
+// StopAll stops all active animations, which emit their cancel signal.
+func StopAll() {
+	for _, t := range active.List() {
+		t.Stop()
+	}
+}
+
+//==============================================================================
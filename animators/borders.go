@@ -0,0 +1,104 @@
+package animators
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/influx6/govfx"
+)
+
+//==============================================================================
+
+// radiusCorners defines the longhands of the corners of border-radius, in the
+// order of its shorthand.
+var radiusCorners = []string{
+	"border-top-left-radius",
+	"border-top-right-radius",
+	"border-bottom-right-radius",
+	"border-bottom-left-radius",
+}
+
+// BorderRadius defines a sequence for animating the border-radius of an
+// element, where Value sets the target radius of all corners and the corner
+// fields override it for their corner, eg 8px, 50% or a relative +=4px.
+// Corners without a target keep their current radius.
+type BorderRadius struct {
+	From        string       `govfx:"from"`
+	Value       string       `govfx:"value"`
+	TopLeft     string       `govfx:"top-left"`
+	TopRight    string       `govfx:"top-right"`
+	BottomRight string       `govfx:"bottom-right"`
+	BottomLeft  string       `govfx:"bottom-left"`
+	Easing      string       `govfx:"easing"`
+	Easer       govfx.Easing `govfx:"easer"`
+
+	start   []string
+	targets []string
+	current []string
+}
+
+// Init initializes the corners with the provided element for animation.
+func (b *BorderRadius) Init(elem govfx.Elemental) {
+	if b.Easer == nil {
+		b.Easer = govfx.GetEasing(b.Easing)
+	}
+
+	corners := []string{b.TopLeft, b.TopRight, b.BottomRight, b.BottomLeft}
+
+	b.start = make([]string, len(radiusCorners))
+	b.targets = make([]string, len(radiusCorners))
+
+	for index, corner := range radiusCorners {
+		b.start[index] = "0px"
+
+		// An elliptical corner starts from its horizontal radius.
+		if b.From != "" {
+			b.start[index] = b.From
+		} else if val, _, ok := elem.Read(corner, ""); ok {
+			if parts := strings.Fields(val); len(parts) > 0 {
+				b.start[index] = parts[0]
+			}
+		}
+
+		switch {
+		case corners[index] != "":
+			b.targets[index] = govfx.RelativeValue(b.start[index], corners[index])
+		case b.Value != "":
+			b.targets[index] = govfx.RelativeValue(b.start[index], b.Value)
+		default:
+			b.targets[index] = b.start[index]
+		}
+	}
+
+	b.current = append([]string(nil), b.start...)
+}
+
+// Update contains the update operations for the corners.
+func (b *BorderRadius) Update(delta float64, timeline float64) {
+	ease := b.Easer.Ease(timeline)
+
+	for index := range radiusCorners {
+		b.current[index] = govfx.LerpValue(b.start[index], b.targets[index], ease)
+	}
+}
+
+// CSS writes the css output to the supplied writer, using the shorthand when
+// the corners collapse into it.
+func (b *BorderRadius) CSS(wc io.Writer) {
+	sides := make(map[string]string, len(radiusCorners))
+	for index, corner := range radiusCorners {
+		sides[corner] = b.current[index]
+	}
+
+	if radius, ok := govfx.CollapseShorthand("border-radius", sides); ok {
+		wc.Write([]byte(fmt.Sprintf("border-radius: %s;", radius)))
+		return
+	}
+
+	for index, corner := range radiusCorners {
+		wc.Write([]byte(fmt.Sprintf("%s: %s;", corner, b.current[index])))
+	}
+}
+
+//==============================================================================
@@ -5,23 +5,31 @@ import (
 	"io"
 
 	"github.com/influx6/govfx"
+	"honnef.co/go/js/dom"
 )
 
 //==============================================================================
 
 // Width provides animation sequencing for width properties, it uses flat integers
-// values and pixels.
+// values and pixels. The written width is bounded by the optional Clamp, eg
+// [2]float64{0, 400}, while a Relative operator, eg +=, animates the width by
+// the Target rather than to it. A ValueFn, when set, takes precedence over the
+// Target, returning the target of each element from its index among the
+// animated elements, eg the bars of a chart.
 type Width struct {
-	Target int          `govfx:"value"`
-	Easing string       `govfx:"easing"`
-	Easer  govfx.Easing `govfx:"Easer"`
-
+	From     string                         `govfx:"from"`
+	Target   int                            `govfx:"value"`
+	ValueFn  func(int, dom.Element) float64 `govfx:"value-fn"`
+	Relative string                         `govfx:"relative"`
+	Clamp    [2]float64                     `govfx:"clamp"`
+	Easing   string                         `govfx:"easing"`
+	Easer    govfx.Easing                   `govfx:"Easer"`
+
+	start   float64
+	target  float64
 	current float64
-	accum   float64
 
 	elem govfx.Elemental
-
-	ended bool
 }
 
 // Init initializes the width property with the provided element for animation.
@@ -32,57 +40,58 @@ func (w *Width) Init(elem govfx.Elemental) {
 		w.Easer = govfx.GetEasing(w.Easing)
 	}
 
-	if ws, _, ok := elem.ReadInt("width", ""); ok {
-		w.current = float64(ws)
+	if w.From != "" {
+		w.start = govfx.ParseFloat(w.From)
+	} else if ws, _, ok := elem.ReadInt("width", ""); ok {
+		w.start = float64(ws)
 	}
+
+	w.target = govfx.ResolveRelative(w.start, targetOf(elem, float64(w.Target), w.ValueFn), w.Relative)
+	w.current = w.start
 }
 
 // Update contains the update operations for the width property.
-// All calculations are handled here, it recieves the delta value to
-// allow
+// All calculations are handled here, it recieves the timeline value to
+// allow easing the width from its start towards the target.
 func (w *Width) Update(delta float64, timeline float64) {
-	cu := int(w.current)
-
 	easer := w.Easer.Ease(timeline)
-
-	if cu < w.Target {
-		w.current += (w.current * delta * easer) + 5
-	} else {
-		w.current = float64(w.Target)
-		w.ended = true
-	}
-}
-
-// Blend takes the last value to which allows us to correct the
-// rendered position of our update.
-func (w *Width) Blend(delta float64) {
-	if !w.ended {
-		w.current += delta
-	}
+	w.current = w.start + ((w.target - w.start) * easer)
 }
 
 // CSS writes the css output to the supplied writer
 func (w *Width) CSS(wc io.Writer) {
-	wc.Write([]byte(fmt.Sprintf("width: %d%s", int(w.current), "px")))
+	wc.Write([]byte(fmt.Sprintf("width: %d%s;", int(govfx.ClampValue(w.current, w.Clamp)), "px")))
 }
 
 //==============================================================================
 
-// Height provides animation sequencing for Height properties.
+// Height provides animation sequencing for Height properties, it uses flat
+// integers values and pixels. When HideOverflow is set, the element's overflow
+// is hidden while the height animates and its inline overflow, if any, is
+// restored once it ends, ensuring collapsing content does not spill out. The
+// written height is bounded by the optional Clamp. A ValueFn takes precedence
+// over the Target, see Width.
 type Height struct {
-	Target int          `govfx:"value"`
-	Easing string       `govfx:"easing"`
-	Easer  govfx.Easing `govfx:"easer"`
-
-	current float64
-	accum   float64
+	From         string                         `govfx:"from"`
+	Target       int                            `govfx:"value"`
+	ValueFn      func(int, dom.Element) float64 `govfx:"value-fn"`
+	Relative     string                         `govfx:"relative"`
+	Clamp        [2]float64                     `govfx:"clamp"`
+	Easing       string                         `govfx:"easing"`
+	Easer        govfx.Easing                   `govfx:"easer"`
+	HideOverflow bool                           `govfx:"hide-overflow"`
+
+	start    float64
+	target   float64
+	current  float64
+	overflow string
 
 	elem govfx.Elemental
 
 	ended bool
 }
 
-// Init initializes the width property with the provided element for animation.
+// Init initializes the height property with the provided element for animation.
 func (h *Height) Init(elem govfx.Elemental) {
 	h.elem = elem
 
@@ -90,38 +99,81 @@ func (h *Height) Init(elem govfx.Elemental) {
 		h.Easer = govfx.GetEasing(h.Easing)
 	}
 
-	if ws, _, ok := elem.ReadInt("width", ""); ok {
-		h.current = float64(ws)
+	if h.From != "" {
+		h.start = govfx.ParseFloat(h.From)
+	} else if hs, _, ok := elem.ReadInt("height", ""); ok {
+		h.start = float64(hs)
+	}
+
+	// Only the inline overflow is restored, leaving the overflow to the
+	// stylesheets of the element when it has none.
+	inline := make(govfx.ComputedStyleMap)
+	inline.AddCSSText(inlineStyle(elem))
+
+	h.overflow = ""
+	if overflow, err := inline.Get("overflow"); err == nil {
+		h.overflow = overflow.Value
+		if overflow.Priority {
+			h.overflow += " !important"
+		}
 	}
+
+	h.target = govfx.ResolveRelative(h.start, targetOf(elem, float64(h.Target), h.ValueFn), h.Relative)
+	h.current = h.start
 }
 
-// Update contains the update operations for the width property.
-// All calculations are handled here, it recieves the delta value to
-// allow
+// Update contains the update operations for the height property.
+// All calculations are handled here, it recieves the timeline value to
+// allow easing the height from its start towards the target.
 func (h *Height) Update(delta float64, timeline float64) {
-	cu := int(h.current)
-
 	easer := h.Easer.Ease(timeline)
+	h.current = h.start + ((h.target - h.start) * easer)
+	h.ended = timeline >= 1
+}
 
-	if cu < h.Target {
-		h.current += (h.current * delta * easer) + 5
-	} else {
-		h.current = float64(h.Target)
-		h.ended = true
+// CSS writes the css output to the supplied writer
+func (h *Height) CSS(wc io.Writer) {
+	wc.Write([]byte(fmt.Sprintf("height: %d%s;", int(govfx.ClampValue(h.current, h.Clamp)), "px")))
+
+	if !h.HideOverflow {
+		return
 	}
-}
 
-// Blend takes the last value to which allows us to correct the
-// rendered position of our update.
-func (h *Height) Blend(delta float64) {
 	if !h.ended {
-		h.current += delta
+		wc.Write([]byte("overflow: hidden;"))
+		return
+	}
+
+	if h.overflow != "" {
+		wc.Write([]byte(fmt.Sprintf("overflow: %s;", h.overflow)))
 	}
 }
 
-// CSS writes the css output to the supplied writer
-func (h *Height) CSS(wc io.Writer) {
-	wc.Write([]byte(fmt.Sprintf("height: %d%s", int(h.current), "px")))
+//==============================================================================
+
+// inlineStyle returns the style attribute of the element, being empty for
+// elements outside of the dom.
+func inlineStyle(elem govfx.Elemental) string {
+	if em, ok := elem.(*govfx.Element); ok && em.Element == nil {
+		return ""
+	}
+
+	return elem.GetAttribute("style")
+}
+
+// targetOf returns the target of the element, being the value returned by the
+// function for the index of the element when set, else the target.
+func targetOf(elem govfx.Elemental, target float64, fn func(int, dom.Element) float64) float64 {
+	if fn == nil {
+		return target
+	}
+
+	var index int
+	if ie, ok := elem.(govfx.Indexable); ok {
+		index = ie.Index()
+	}
+
+	return fn(index, elem)
 }
 
 //==============================================================================
@@ -9,129 +9,104 @@ import (
 
 //==============================================================================
 
-// Colors defines a global handle for color transisition.
-var Colors ColorTransistion
-
-// ColorTransistion defines a struct for defining color functions.
-type ColorTransistion struct{}
-
-// Interpolate interpolates the current color towards the base color using the
-// delta and timeline values and the easing provider.
-func (ColorTransistion) Interpolate(easing govfx.Easing, base, current ColorValue, delta float64, timeline float64) ColorValue {
-	var newcolor ColorValue
-
-	return newcolor
-}
-
-// Blend returns a new ColorValue with the giving blend function.
-func (ColorTransistion) Blend(easing govfx.Easing, current ColorValue, blend float64) ColorValue {
-	var newcolor ColorValue
-
-	return newcolor
-}
-
-//==============================================================================
-
-// ColorValue defines a struct which represents a color value set.
-type ColorValue struct {
-	red   int
-	green int
-	blue  int
-	alpah float64
-}
-
-// RGBA writes out the color values in RGBA format.
-func (c ColorValue) RGBA() string {
-	return fmt.Sprintf("rgba(%d,%d,%d,%.2f)", c.red, c.green, c.blue, c.alpah)
-}
-
-// RGB writes out the color values in RGB format.
-func (c ColorValue) RGB() string {
-	return fmt.Sprintf("rgba(%d,%d,%d,1)", c.red, c.green, c.blue)
-}
-
-// Color provides a animator for sequencing color animations.
+// Color provides a animator for sequencing color animations. Its target may
+// be supplied as a hex, rgb(a), hsl(a) or named color.
 type Color struct {
-	Alpha  bool   `govfx:"alpha"`
-	Color  string `govfx:"color"`
-	Easing string `govfx:"easing"`
-
-	color ColorValue
-	base  ColorValue
-
-	elem govfx.Elemental
+	From   string       `govfx:"from"`
+	Target string       `govfx:"value"`
+	HSL    bool         `govfx:"hsl"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	start   string
+	current string
 }
 
 // Init initializes the property for execution.
 func (t *Color) Init(elem govfx.Elemental) {
-	t.elem = elem
-
-	rw, gw, bw, aw := govfx.ParseRGB(t.Color)
-	t.color = ColorValue{red: rw, green: gw, blue: bw, alpah: aw}
+	if t.Easer == nil {
+		t.Easer = govfx.GetEasing(t.Easing)
+	}
 
-	if color, _, ok := elem.Read("color", ""); ok {
-		r, g, b, a := govfx.ParseRGB(color)
-		t.color = ColorValue{red: r, green: g, blue: b, alpah: a}
+	t.start = t.From
+	if t.start == "" {
+		t.start = readColor(elem, "color", "rgb(0,0,0)")
 	}
+	t.current = t.start
 }
 
 // Update updates the property details.
 func (t *Color) Update(delta float64, timeline float64) {
-	easing := govfx.GetEasing(t.Easing)
-	t.color = Colors.Interpolate(easing, t.base, t.color, delta, timeline)
-}
-
-// Blend adjust the property details to match appropriate state.
-func (t *Color) Blend(interpolation float64) {
-	easing := govfx.GetEasing(t.Easing)
-	t.color = Colors.Blend(easing, t.color, interpolation)
+	t.current = govfx.LerpColor(t.start, t.Target, t.Easer.Ease(timeline), colorMode(t.HSL))
 }
 
 // CSS writes out the current state of the property in css format to the provided
 // writer.
 func (t *Color) CSS(owner io.Writer) {
-	if t.Alpha {
-		owner.Write([]byte("color:" + t.color.RGBA()))
-		return
-	}
-
-	owner.Write([]byte("color:" + t.color.RGB()))
+	owner.Write([]byte(fmt.Sprintf("color: %s;", t.current)))
 }
 
 //==============================================================================
 
-// BackgroundColor provides a animator for sequencing background color animations.
+// BackgroundColor provides a animator for sequencing background color
+// animations. Its target may be supplied as a hex, rgb(a), hsl(a) or named
+// color.
 type BackgroundColor struct {
-	Alpha  bool   `govfx:"alpha"`
-	Color  string `govfx:"color"`
-	Easing string `govfx:"easing"`
+	From   string       `govfx:"from"`
+	Target string       `govfx:"value"`
+	HSL    bool         `govfx:"hsl"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	start   string
+	current string
+}
 
-	color ColorValue
-	base  ColorValue
+// Init initializes the property for execution.
+func (t *BackgroundColor) Init(elem govfx.Elemental) {
+	if t.Easer == nil {
+		t.Easer = govfx.GetEasing(t.Easing)
+	}
 
-	elem  govfx.Elemental
-	easer govfx.Easing
+	t.start = t.From
+	if t.start == "" {
+		t.start = readColor(elem, "background-color", "transparent")
+	}
+	t.current = t.start
 }
 
 // Update updates the property details.
 func (t *BackgroundColor) Update(delta float64, timeline float64) {
-	easing := govfx.GetEasing(t.Easing)
-	t.color = Colors.Interpolate(easing, t.base, t.color, delta, timeline)
-}
-
-// Blend adjust the property details to match appropriate state.
-func (t *BackgroundColor) Blend(interpolation float64) {
-	easing := govfx.GetEasing(t.Easing)
-	t.color = Colors.Blend(easing, t.color, interpolation)
+	t.current = govfx.LerpColor(t.start, t.Target, t.Easer.Ease(timeline), colorMode(t.HSL))
 }
 
 // CSS writes out the current state of the property in css format to the provided
 // writer.
 func (t *BackgroundColor) CSS(owner io.Writer) {
-	if t.Alpha {
-		owner.Write([]byte("background-color: " + t.color.RGBA()))
-		return
+	owner.Write([]byte(fmt.Sprintf("background-color: %s;", t.current)))
+}
+
+//==============================================================================
+
+// readColor returns the current color of the giving property of the element,
+// else returning the default color. Computed styles report colors in their
+// rgb(a) form, which the color interpolation accepts alongside hex and named
+// colors.
+func readColor(elem govfx.Elemental, prop string, def string) string {
+	if color, _, ok := elem.Read(prop, ""); ok && color != "" {
+		return color
+	}
+
+	return def
+}
+
+// colorMode returns the color space the color interpolation should use.
+func colorMode(hsl bool) govfx.ColorMode {
+	if hsl {
+		return govfx.HSLMode
 	}
 
-	owner.Write([]byte("background-color: " + t.color.RGB()))
+	return govfx.RGBMode
 }
+
+//==============================================================================
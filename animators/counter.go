@@ -0,0 +1,83 @@
+package animators
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/influx6/govfx"
+	"honnef.co/go/js/dom"
+)
+
+//==============================================================================
+
+// Counter defines a sequence for animating the text content of its Target as
+// a number counting from From to To, eg an animated total ticking up to 1000.
+// The number is written with the giving Decimals, unless a Format is provided
+// to add separators or a currency to it. A nil Target sets the text of the
+// element being animated. The text is set directly every frame, hence the
+// sequence writes no css.
+type Counter struct {
+	From     float64              `govfx:"from"`
+	To       float64              `govfx:"to"`
+	Decimals int                  `govfx:"decimals"`
+	Target   dom.Element          `govfx:"target"`
+	Format   func(float64) string `govfx:"format"`
+	Easing   string               `govfx:"easing"`
+	Easer    govfx.Easing         `govfx:"easer"`
+
+	target dom.Element
+	text   string
+}
+
+// Init initializes the counter with the provided element for animation.
+func (c *Counter) Init(elem govfx.Elemental) {
+	if c.Easer == nil {
+		c.Easer = govfx.GetEasing(c.Easing)
+	}
+
+	c.target = c.Target
+	if c.target == nil {
+		c.target = elem
+	}
+
+	c.text = ""
+}
+
+// Effectful returns true, as the text is set outside of the css of the
+// element.
+func (c *Counter) Effectful() bool {
+	return true
+}
+
+// Transitionable returns false, as the text of the counter can not be
+// transitioned.
+func (c *Counter) Transitionable() bool {
+	return false
+}
+
+// Update sets the text of the target to the number at the current point of
+// the timeline.
+func (c *Counter) Update(delta float64, timeline float64) {
+	value := c.From + ((c.To - c.From) * c.Easer.Ease(timeline))
+
+	var text string
+
+	if c.Format != nil {
+		text = c.Format(value)
+	} else {
+		text = strconv.FormatFloat(value, 'f', c.Decimals, 64)
+	}
+
+	// Skip touching the dom while the visible number stays the same.
+	if text == c.text {
+		return
+	}
+
+	c.text = text
+	c.target.SetTextContent(text)
+}
+
+// CSS writes no css, as the counter only sets the text of its target.
+func (c *Counter) CSS(wc io.Writer) {}
+
+//==============================================================================
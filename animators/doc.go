@@ -1,6 +1,11 @@
 // Package animators implements different animation sequencers for the GoVfx
 // package, allowing you to build extensive animations using just the predefined
 // core.
+//
+// Animators start from the current computed value of their property, unless
+// a From value is provided, which forces the start of the animation
+// regardless of the state of the element, eg always fading in from an
+// opacity of 0.
 package animators
 
 //==============================================================================
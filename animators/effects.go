@@ -0,0 +1,81 @@
+package animators
+
+import (
+	"fmt"
+
+	"github.com/influx6/govfx"
+)
+
+//==============================================================================
+
+// FadeInEffect builds the fadeIn effect, fading the elements in from an
+// opacity of 0.
+func FadeInEffect(opts govfx.Value) govfx.Values {
+	return govfx.Values{
+		{"animate": "opacity", "from": "0", "value": 1.0},
+	}
+}
+
+// FadeOutEffect builds the fadeOut effect, fading the elements out to an
+// opacity of 0.
+func FadeOutEffect(opts govfx.Value) govfx.Values {
+	return govfx.Values{
+		{"animate": "opacity", "value": 0.0},
+	}
+}
+
+// SlideInEffect returns the builder of an effect sliding the elements in
+// along the axis, x or y, from the side given by the sign, eg -1 for the
+// left. The distance option sets how far the elements slide, which defaults
+// to 100 in the unit option, being % when unset.
+func SlideInEffect(axis string, sign float64) govfx.EffectBuilder {
+	return func(opts govfx.Value) govfx.Values {
+		distance := effectFloat(opts, "distance", 100)
+
+		unit, ok := opts["unit"].(string)
+		if !ok {
+			unit = "%"
+		}
+
+		return govfx.Values{
+			{"animate": "translate-" + axis, "from": fmt.Sprintf("%g", sign*distance), "value": 0.0, "unit": unit},
+		}
+	}
+}
+
+// ZoomInEffect builds the zoomIn effect, fading the elements in as they grow
+// from the scale option, which defaults to 0.3.
+func ZoomInEffect(opts govfx.Value) govfx.Values {
+	return govfx.Values{
+		{"animate": "opacity", "from": "0", "value": 1.0},
+		{"animate": "scale", "from": fmt.Sprintf("%g", effectFloat(opts, "scale", 0.3)), "x": 1.0, "y": 1.0},
+	}
+}
+
+// ZoomOutEffect builds the zoomOut effect, fading the elements out as they
+// shrink towards the scale option, which defaults to 0.3.
+func ZoomOutEffect(opts govfx.Value) govfx.Values {
+	scale := effectFloat(opts, "scale", 0.3)
+
+	return govfx.Values{
+		{"animate": "opacity", "value": 0.0},
+		{"animate": "scale", "x": scale, "y": scale},
+	}
+}
+
+//==============================================================================
+
+// effectFloat returns the numeric option of the effect under the key, else
+// the default when the option is not a number.
+func effectFloat(opts govfx.Value, key string, def float64) float64 {
+	switch val := opts[key].(type) {
+	case float64:
+		return val
+	case int:
+		return float64(val)
+	}
+
+	return def
+}
+
+//==============================================================================
@@ -0,0 +1,93 @@
+package animators
+
+import (
+	"io"
+	"strings"
+
+	"github.com/influx6/govfx"
+)
+
+//==============================================================================
+
+// FadeIn defines a sequence for fading an element in from hidden, animating
+// its opacity towards 1 while writing its Display, which defaults to block,
+// from the first frame, as display can not be animated. Elements which are
+// not displayed start from an opacity of 0, else from their current opacity.
+type FadeIn struct {
+	From    string       `govfx:"from"`
+	Display string       `govfx:"display"`
+	Easing  string       `govfx:"easing"`
+	Easer   govfx.Easing `govfx:"easer"`
+
+	opacity Opacity
+}
+
+// Init initializes the fade with the provided element for animation.
+func (f *FadeIn) Init(elem govfx.Elemental) {
+	if f.Display == "" {
+		f.Display = "block"
+	}
+
+	from := f.From
+	if display, _, _ := elem.Read("display", ""); from == "" && strings.TrimSpace(display) == "none" {
+		from = "0"
+	}
+
+	f.opacity = Opacity{From: from, Target: 1, Easing: f.Easing, Easer: f.Easer}
+	f.opacity.Init(elem)
+}
+
+// Update contains the update operations for the fade.
+func (f *FadeIn) Update(delta float64, timeline float64) {
+	f.opacity.Update(delta, timeline)
+}
+
+// CSS writes the css output to the supplied writer.
+func (f *FadeIn) CSS(wc io.Writer) {
+	wc.Write([]byte("display: " + f.Display + "; "))
+	f.opacity.CSS(wc)
+}
+
+//==============================================================================
+
+// FadeOut defines a sequence for fading an element out, animating its
+// opacity towards 0 before writing a display of none on the last frame, which
+// takes the element out of the layout of the page.
+type FadeOut struct {
+	From   string       `govfx:"from"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	opacity Opacity
+	ended   bool
+}
+
+// Init initializes the fade with the provided element for animation.
+func (f *FadeOut) Init(elem govfx.Elemental) {
+	f.opacity = Opacity{From: f.From, Easing: f.Easing, Easer: f.Easer}
+	f.opacity.Init(elem)
+	f.ended = false
+}
+
+// Transitionable returns false as the display of the last frame can not be
+// transitioned.
+func (f *FadeOut) Transitionable() bool {
+	return false
+}
+
+// Update contains the update operations for the fade.
+func (f *FadeOut) Update(delta float64, timeline float64) {
+	f.opacity.Update(delta, timeline)
+	f.ended = timeline >= 1
+}
+
+// CSS writes the css output to the supplied writer.
+func (f *FadeOut) CSS(wc io.Writer) {
+	f.opacity.CSS(wc)
+
+	if f.ended {
+		wc.Write([]byte(" display: none;"))
+	}
+}
+
+//==============================================================================
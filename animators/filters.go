@@ -0,0 +1,241 @@
+package animators
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/influx6/govfx"
+)
+
+//==============================================================================
+
+// filterIdentities defines the values of the filter functions which leave an
+// element unchanged.
+var filterIdentities = map[string]string{
+	"blur":       "0px",
+	"brightness": "1",
+	"contrast":   "1",
+	"grayscale":  "0",
+	"hue-rotate": "0",
+	"invert":     "0",
+	"opacity":    "1",
+	"saturate":   "1",
+	"sepia":      "0",
+}
+
+// filterAngles defines the filter functions taking an angle, which are
+// interpolated in degrees.
+var filterAngles = map[string]bool{
+	"hue-rotate": true,
+}
+
+// Filter defines a sequence for animating the filter functions of an element
+// towards its target, eg blur(4px) grayscale(1). The functions are matched by
+// name, where a function missing from either the start or target is animated
+// from or towards its identity, eg blur(0px), hence a filter of none animates
+// into any filter. Percentages are read as their fraction, eg brightness(50%)
+// as brightness(0.5).
+type Filter struct {
+	From   string       `govfx:"from"`
+	Target string       `govfx:"value"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	filters filterList
+}
+
+// Init initializes the filter with the provided element for animation.
+func (f *Filter) Init(elem govfx.Elemental) {
+	if f.Easer == nil {
+		f.Easer = govfx.GetEasing(f.Easing)
+	}
+
+	start := f.From
+	if start == "" {
+		start, _, _ = elem.Read("filter", "")
+	}
+
+	f.filters.init(start, f.Target)
+}
+
+// Update contains the update operations for the filter.
+func (f *Filter) Update(delta float64, timeline float64) {
+	f.filters.update(f.Easer.Ease(timeline))
+}
+
+// CSS writes the css output to the supplied writer.
+func (f *Filter) CSS(wc io.Writer) {
+	if len(f.filters.names) == 0 {
+		return
+	}
+
+	wc.Write([]byte(fmt.Sprintf("filter: %s;", f.filters)))
+}
+
+//==============================================================================
+
+// BackdropFilter defines a sequence for animating the backdrop-filter of an
+// element, eg blur(8px) saturate(1.5) for a frosted glass behind a modal,
+// matching its functions as Filter does. The -webkit- prefixed form is
+// written ahead of the standard property, which Safari still requires.
+type BackdropFilter struct {
+	From   string       `govfx:"from"`
+	Target string       `govfx:"value"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	filters filterList
+}
+
+// Init initializes the backdrop filter with the provided element for
+// animation.
+func (b *BackdropFilter) Init(elem govfx.Elemental) {
+	if b.Easer == nil {
+		b.Easer = govfx.GetEasing(b.Easing)
+	}
+
+	start := b.From
+	if start == "" {
+		start, _, _ = elem.Read("backdrop-filter", "")
+	}
+
+	if start == "" {
+		start, _, _ = elem.Read("-webkit-backdrop-filter", "")
+	}
+
+	b.filters.init(start, b.Target)
+}
+
+// Update contains the update operations for the backdrop filter.
+func (b *BackdropFilter) Update(delta float64, timeline float64) {
+	b.filters.update(b.Easer.Ease(timeline))
+}
+
+// CSS writes the css output to the supplied writer.
+func (b *BackdropFilter) CSS(wc io.Writer) {
+	if len(b.filters.names) == 0 {
+		return
+	}
+
+	var decls []string
+	for _, name := range govfx.Vendorize("backdrop-filter") {
+		if name == "backdrop-filter" || strings.HasPrefix(name, "-webkit-") {
+			decls = append(decls, fmt.Sprintf("%s: %s;", name, b.filters))
+		}
+	}
+
+	wc.Write([]byte(strings.Join(decls, " ")))
+}
+
+//==============================================================================
+
+// filterList defines the functions of a filter animated from their start
+// towards their target.
+type filterList struct {
+	names   []string
+	start   []string
+	targets []string
+	current []string
+}
+
+// init sets the functions of the list from the start and target filters.
+func (f *filterList) init(start, target string) {
+	starts := parseFilters(start)
+	targets := parseFilters(target)
+
+	f.names, f.start, f.targets = nil, nil, nil
+
+	// The functions keep the order of the target, followed by the functions
+	// only found within the start.
+	for _, fn := range append(targets, starts...) {
+		if containsName(f.names, fn[0]) {
+			continue
+		}
+
+		f.names = append(f.names, fn[0])
+		f.start = append(f.start, filterValue(starts, fn[0]))
+		f.targets = append(f.targets, filterValue(targets, fn[0]))
+	}
+
+	f.current = append([]string(nil), f.start...)
+}
+
+// update interpolates the functions of the list by the eased progress.
+func (f *filterList) update(ease float64) {
+	for index := range f.names {
+		f.current[index] = govfx.LerpValue(f.start[index], f.targets[index], ease)
+	}
+}
+
+// String returns the current functions of the list as a filter value.
+func (f filterList) String() string {
+	funcs := make([]string, len(f.names))
+	for index, name := range f.names {
+		if filterAngles[name] {
+			funcs[index] = fmt.Sprintf("%s(%sdeg)", name, f.current[index])
+			continue
+		}
+
+		funcs[index] = fmt.Sprintf("%s(%s)", name, f.current[index])
+	}
+
+	return strings.Join(funcs, " ")
+}
+
+//==============================================================================
+
+// parseFilters returns the name and argument of each function of the filter,
+// reading angles in degrees and percentages of the functions other than blur
+// as their fraction.
+func parseFilters(value string) [][2]string {
+	var filters [][2]string
+
+	if value = strings.TrimSpace(value); value == "none" {
+		return nil
+	}
+
+	for _, fn := range govfx.SplitTopLevel(value, ' ') {
+		name := govfx.ValueName(fn)
+
+		arg := strings.Join(govfx.FunctionArgs(fn), ", ")
+		if arg == "" {
+			arg = filterIdentities[name]
+		}
+
+		if filterAngles[name] {
+			arg = strings.TrimSuffix(strings.ToLower(arg), "deg")
+		} else if mag, unit, err := govfx.ParseUnit(arg); err == nil && unit == "%" && name != "blur" {
+			arg = govfx.FormatUnit(mag/100, "")
+		}
+
+		filters = append(filters, [2]string{name, arg})
+	}
+
+	return filters
+}
+
+// filterValue returns the argument of the named function within the filters,
+// else the identity of the function.
+func filterValue(filters [][2]string, name string) string {
+	for _, fn := range filters {
+		if fn[0] == name {
+			return fn[1]
+		}
+	}
+
+	return filterIdentities[name]
+}
+
+// containsName returns true/false if the name is within the list.
+func containsName(names []string, name string) bool {
+	for _, item := range names {
+		if item == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+//==============================================================================
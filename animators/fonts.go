@@ -0,0 +1,113 @@
+package animators
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/influx6/govfx"
+	"honnef.co/go/js/dom"
+)
+
+//==============================================================================
+
+// FontSize defines a sequence for animating the font-size of an element
+// towards the Value in its Unit, which defaults to px. As font-sizes are
+// computed in px, em and % values are resolved against the font-size of the
+// parent of the element and rem values against that of the root element as
+// the sequence initializes. The font-size animates in px, and is written in
+// px unless Authored is set, writing it in its Unit instead. Elements whose
+// parent animates its font-size alongside them resolve against the parent's
+// font-size at the start.
+type FontSize struct {
+	From     string       `govfx:"from"`
+	Value    float64      `govfx:"value"`
+	Unit     string       `govfx:"unit"`
+	Authored bool         `govfx:"authored"`
+	Easing   string       `govfx:"easing"`
+	Easer    govfx.Easing `govfx:"easer"`
+
+	unit    string
+	basis   float64
+	start   float64
+	target  float64
+	current float64
+}
+
+// Init initializes the font-size with the provided element for animation.
+func (f *FontSize) Init(elem govfx.Elemental) {
+	if f.Easer == nil {
+		f.Easer = govfx.GetEasing(f.Easing)
+	}
+
+	if f.unit = strings.ToLower(f.Unit); f.unit == "" {
+		f.unit = "px"
+	}
+
+	f.basis = fontBasis(elem, f.unit)
+	f.target = f.Value * f.basis
+
+	if f.From != "" {
+		if mag, unit, err := govfx.ParseUnit(f.From); err == nil {
+			f.start = mag * fontBasis(elem, unit)
+		}
+	} else {
+		f.start, _, _ = elem.ReadFloat("font-size", "")
+	}
+
+	f.current = f.start
+}
+
+// Update contains the update operations for the font-size.
+func (f *FontSize) Update(delta float64, timeline float64) {
+	f.current = f.start + ((f.target - f.start) * f.Easer.Ease(timeline))
+}
+
+// CSS writes the css output to the supplied writer.
+func (f *FontSize) CSS(wc io.Writer) {
+	if f.Authored && f.unit != "px" && f.basis > 0 {
+		wc.Write([]byte(fmt.Sprintf("font-size: %.2f%s;", f.current/f.basis, f.unit)))
+		return
+	}
+
+	wc.Write([]byte(fmt.Sprintf("font-size: %.2fpx;", f.current)))
+}
+
+//==============================================================================
+
+// fontBasis returns the pixels of a single unit of the font-size of the
+// element, resolved by govfx.ResolveUnit, where em and % resolve against the
+// parent of the element. Units which can not be resolved, eg for elements
+// outside of the dom, count as pixels.
+func fontBasis(elem govfx.Elemental, unit string) float64 {
+	if unit == "" || unit == "px" {
+		return 1
+	}
+
+	em, ok := elem.(*govfx.Element)
+	if !ok || em.Element == nil {
+		return 1
+	}
+
+	var ref dom.Element = em.Element
+
+	value := "1" + unit
+	switch unit {
+	case "%":
+		value = "0.01em"
+		fallthrough
+	case "em":
+		if parent := ref.ParentElement(); parent != nil {
+			ref = parent
+		}
+	}
+
+	basis, err := govfx.ResolveUnit(value, ref)
+	if err != nil {
+		return 1
+	}
+
+	return basis
+}
+
+//==============================================================================
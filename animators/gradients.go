@@ -0,0 +1,218 @@
+package animators
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/influx6/govfx"
+)
+
+//==============================================================================
+
+// ErrGradientStops is returned by a LinearGradient whose start and target
+// gradients have a different number of color stops.
+var ErrGradientStops = errors.New("Gradients differ in their number of stops")
+
+// ErrInvalidGradient is returned by a LinearGradient whose start or target is
+// not a linear-gradient.
+var ErrInvalidGradient = errors.New("Invalid linear-gradient")
+
+// LinearGradient defines a sequence for animating a linear-gradient within
+// the background-image of an element towards its target gradient, eg
+// linear-gradient(90deg, #f00 0%, #00f 100%). The angle, and the color and
+// position of each stop are interpolated, which requires both gradients to
+// have the same number of stops. A start of none fades the stops of the
+// target in from transparent. Side keywords, eg to right, are read as their
+// angle, where corners are read as a square box would point them.
+type LinearGradient struct {
+	From   string       `govfx:"from"`
+	Target string       `govfx:"value"`
+	HSL    bool         `govfx:"hsl"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	start   gradient
+	target  gradient
+	current gradient
+	err     error
+}
+
+// Init initializes the gradient with the provided element for animation.
+func (l *LinearGradient) Init(elem govfx.Elemental) {
+	if l.Easer == nil {
+		l.Easer = govfx.GetEasing(l.Easing)
+	}
+
+	start := l.From
+	if start == "" {
+		start, _, _ = elem.Read("background-image", "")
+	}
+
+	l.target, l.err = parseGradient(l.Target)
+	if l.err != nil {
+		return
+	}
+
+	if start = strings.TrimSpace(start); start == "" || strings.EqualFold(start, "none") {
+		l.start = l.target.transparent()
+	} else if l.start, l.err = parseGradient(start); l.err != nil {
+		return
+	}
+
+	if len(l.start.stops) != len(l.target.stops) {
+		l.err = ErrGradientStops
+		return
+	}
+
+	l.current = l.start.copy()
+}
+
+// Err returns the error of the gradients given, if they could not be parsed
+// or differ in their number of stops.
+func (l *LinearGradient) Err() error {
+	return l.err
+}
+
+// Transitionable returns false, as browsers do not transition the
+// background-image.
+func (l *LinearGradient) Transitionable() bool {
+	return false
+}
+
+// Update contains the update operations for the gradient.
+func (l *LinearGradient) Update(delta float64, timeline float64) {
+	if l.err != nil {
+		return
+	}
+
+	ease := l.Easer.Ease(timeline)
+
+	l.current.angle = l.start.angle + ((l.target.angle - l.start.angle) * ease)
+
+	for index, stop := range l.start.stops {
+		to := l.target.stops[index]
+
+		l.current.stops[index] = gradientStop{
+			color:    govfx.LerpColor(stop.color, to.color, ease, colorMode(l.HSL)),
+			position: govfx.LerpValue(stop.position, to.position, ease),
+		}
+	}
+}
+
+// CSS writes the css output to the supplied writer.
+func (l *LinearGradient) CSS(wc io.Writer) {
+	if l.err != nil {
+		return
+	}
+
+	wc.Write([]byte(fmt.Sprintf("background-image: %s;", l.current)))
+}
+
+//==============================================================================
+
+// gradientSides defines the angles of the side keywords of gradients.
+var gradientSides = map[string]float64{
+	"to top":          0,
+	"to top right":    45,
+	"to right top":    45,
+	"to right":        90,
+	"to bottom right": 135,
+	"to right bottom": 135,
+	"to bottom":       180,
+	"to bottom left":  225,
+	"to left bottom":  225,
+	"to left":         270,
+	"to top left":     315,
+	"to left top":     315,
+}
+
+// gradient defines the angle and color stops of a linear-gradient.
+type gradient struct {
+	angle float64
+	stops []gradientStop
+}
+
+// gradientStop defines a color stop of a gradient.
+type gradientStop struct {
+	color    string
+	position string
+}
+
+// parseGradient parses a linear-gradient value, defaulting its angle to
+// 180deg and spreading the stops without a position evenly.
+func parseGradient(value string) (gradient, error) {
+	value = strings.TrimSpace(value)
+
+	if !strings.HasPrefix(strings.ToLower(value), "linear-gradient(") || !strings.HasSuffix(value, ")") {
+		return gradient{}, ErrInvalidGradient
+	}
+
+	args := govfx.SplitTopLevel(value[len("linear-gradient("):len(value)-1], ',')
+	grad := gradient{angle: 180}
+
+	if first := strings.ToLower(strings.TrimSpace(args[0])); strings.HasSuffix(first, "deg") {
+		if mag, _, err := govfx.ParseUnit(strings.TrimSuffix(first, "deg")); err == nil {
+			grad.angle = mag
+			args = args[1:]
+		}
+	} else if angle, ok := gradientSides[strings.Join(strings.Fields(first), " ")]; ok {
+		grad.angle = angle
+		args = args[1:]
+	}
+
+	if len(args) < 2 {
+		return gradient{}, ErrInvalidGradient
+	}
+
+	for index, arg := range args {
+		parts := govfx.SplitTopLevel(arg, ' ')
+
+		if len(parts) == 0 || !govfx.IsColor(parts[0]) {
+			return gradient{}, ErrInvalidGradient
+		}
+
+		stop := gradientStop{
+			color:    parts[0],
+			position: fmt.Sprintf("%.2f%%", float64(index)*100/float64(len(args)-1)),
+		}
+
+		if len(parts) > 1 {
+			stop.position = parts[len(parts)-1]
+		}
+
+		grad.stops = append(grad.stops, stop)
+	}
+
+	return grad, nil
+}
+
+// transparent returns a copy of the gradient with transparent stops.
+func (g gradient) transparent() gradient {
+	faded := g.copy()
+
+	for index := range faded.stops {
+		faded.stops[index].color = "transparent"
+	}
+
+	return faded
+}
+
+// copy returns a copy of the gradient.
+func (g gradient) copy() gradient {
+	return gradient{angle: g.angle, stops: append([]gradientStop(nil), g.stops...)}
+}
+
+// String returns the gradient as a linear-gradient value.
+func (g gradient) String() string {
+	parts := []string{fmt.Sprintf("%.2fdeg", g.angle)}
+
+	for _, stop := range g.stops {
+		parts = append(parts, stop.color+" "+stop.position)
+	}
+
+	return "linear-gradient(" + strings.Join(parts, ", ") + ")"
+}
+
+//==============================================================================
@@ -0,0 +1,56 @@
+package animators
+
+import "testing"
+
+// TestParseGradient validates the behaviour of parsing linear-gradient values
+// into their angle and color stops.
+func TestParseGradient(t *testing.T) {
+	gradients := map[string]gradient{
+		"linear-gradient(#f00, #00f)": {angle: 180, stops: []gradientStop{
+			{color: "#f00", position: "0.00%"},
+			{color: "#00f", position: "100.00%"},
+		}},
+		"linear-gradient(to right, #f00 10%, #0f0, #00f)": {angle: 90, stops: []gradientStop{
+			{color: "#f00", position: "10%"},
+			{color: "#0f0", position: "50.00%"},
+			{color: "#00f", position: "100.00%"},
+		}},
+		"linear-gradient(45deg, #f00 0%, #00f 100%)": {angle: 45, stops: []gradientStop{
+			{color: "#f00", position: "0%"},
+			{color: "#00f", position: "100%"},
+		}},
+	}
+
+	for value, expected := range gradients {
+		grad, err := parseGradient(value)
+		if err != nil || grad.String() != expected.String() {
+			t.Errorf("Expected parseGradient(%q) to be %s but got %s with %v", value, expected, grad, err)
+		}
+	}
+
+	for _, value := range []string{"linear-gradient(45deg, #f00)", "radial-gradient(#f00, #00f)", "linear-gradient(10px, #f00)", "none"} {
+		if _, err := parseGradient(value); err != ErrInvalidGradient {
+			t.Errorf("Expected parseGradient(%q) to fail but got %v", value, err)
+		}
+	}
+}
+
+// TestLinearGradientStops validates the behaviour of gradients differing in
+// their number of stops, which can not be interpolated.
+func TestLinearGradientStops(t *testing.T) {
+	stops := map[string]error{
+		"linear-gradient(#f00, #00f)":        nil,
+		"linear-gradient(90deg, #0f0, #00f)": nil,
+		"linear-gradient(#f00, #0f0, #00f)":  ErrGradientStops,
+		"none":                               nil,
+	}
+
+	for from, expected := range stops {
+		lg := LinearGradient{From: from, Target: "linear-gradient(#000, #fff)", Easing: "linear"}
+		lg.Init(nil)
+
+		if err := lg.Err(); err != expected {
+			t.Errorf("Expected the gradient from %q to fail with %v but got %v", from, expected, err)
+		}
+	}
+}
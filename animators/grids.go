@@ -0,0 +1,238 @@
+package animators
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/influx6/govfx"
+)
+
+//==============================================================================
+
+// ErrGridTracks is returned by a grid template sequence whose start and
+// target track lists have a different number of tracks.
+var ErrGridTracks = errors.New("Track lists differ in their number of tracks")
+
+// ErrGridUnits is returned by a grid template sequence whose start and target
+// tracks can not be interpolated, being tracks of different units or
+// keyword and function tracks, eg auto or minmax(), which differ.
+var ErrGridUnits = errors.New("Track lists differ in their track units")
+
+// GridTemplateColumns defines a sequence for animating the track sizes of
+// the grid-template-columns of an element towards its target track list, eg
+// 200px 1fr towards 300px 1fr for an expanding sidebar. Each track is
+// interpolated in its own unit, fr, px or %, hence both track lists must hold
+// the same number of tracks in matching units, where keyword and function
+// tracks, eg minmax(100px, 1fr), must be the same within both. As the
+// computed track list of an element is in pixels, without a From the pixel
+// tracks computed for fr targets start at their share of the free space
+// split by the fr of the targets, eg 200px 600px starts 300px 1fr at 1fr.
+type GridTemplateColumns struct {
+	From   string       `govfx:"from"`
+	Target string       `govfx:"value"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	tracks gridTracks
+}
+
+// Init initializes the track list with the provided element for animation.
+func (g *GridTemplateColumns) Init(elem govfx.Elemental) {
+	if g.Easer == nil {
+		g.Easer = govfx.GetEasing(g.Easing)
+	}
+
+	g.tracks.init(elem, "grid-template-columns", g.From, g.Target)
+}
+
+// Err returns the error of the track lists given, if they differ in their
+// number of tracks or track units.
+func (g *GridTemplateColumns) Err() error {
+	return g.tracks.err
+}
+
+// Update contains the update operations for the track list.
+func (g *GridTemplateColumns) Update(delta float64, timeline float64) {
+	g.tracks.update(g.Easer.Ease(timeline))
+}
+
+// CSS writes the css output to the supplied writer.
+func (g *GridTemplateColumns) CSS(wc io.Writer) {
+	g.tracks.css(wc)
+}
+
+//==============================================================================
+
+// GridTemplateRows defines a sequence for animating the track sizes of the
+// grid-template-rows of an element towards its target track list, see
+// GridTemplateColumns.
+type GridTemplateRows struct {
+	From   string       `govfx:"from"`
+	Target string       `govfx:"value"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	tracks gridTracks
+}
+
+// Init initializes the track list with the provided element for animation.
+func (g *GridTemplateRows) Init(elem govfx.Elemental) {
+	if g.Easer == nil {
+		g.Easer = govfx.GetEasing(g.Easing)
+	}
+
+	g.tracks.init(elem, "grid-template-rows", g.From, g.Target)
+}
+
+// Err returns the error of the track lists given, if they differ in their
+// number of tracks or track units.
+func (g *GridTemplateRows) Err() error {
+	return g.tracks.err
+}
+
+// Update contains the update operations for the track list.
+func (g *GridTemplateRows) Update(delta float64, timeline float64) {
+	g.tracks.update(g.Easer.Ease(timeline))
+}
+
+// CSS writes the css output to the supplied writer.
+func (g *GridTemplateRows) CSS(wc io.Writer) {
+	g.tracks.css(wc)
+}
+
+//==============================================================================
+
+// gridTracks defines the tracks of a grid template property animated from
+// their start towards their target.
+type gridTracks struct {
+	property string
+	tracks   []gridTrack
+	current  []string
+	err      error
+}
+
+// gridTrack defines a track of a track list, being a size interpolated in its
+// unit, else a keyword or function track kept as is.
+type gridTrack struct {
+	keyword string
+	unit    string
+	start   float64
+	target  float64
+}
+
+// init sets the tracks from the start, else the property of the element,
+// and target track lists.
+func (g *gridTracks) init(elem govfx.Elemental, property, from, target string) {
+	g.property = property
+	g.tracks, g.current, g.err = nil, nil, nil
+
+	computed := from == ""
+	if computed {
+		from, _, _ = elem.Read(property, "")
+	}
+
+	starts := govfx.SplitTopLevel(from, ' ')
+	targets := govfx.SplitTopLevel(target, ' ')
+
+	if len(starts) != len(targets) {
+		g.err = ErrGridTracks
+		return
+	}
+
+	var shares map[int]float64
+	if computed {
+		shares = frShares(starts, targets)
+	}
+
+	for index, track := range starts {
+		mag, unit, ok := parseTrack(track)
+		toMag, toUnit, toOk := parseTrack(targets[index])
+
+		if share, ok := shares[index]; ok {
+			mag, unit = share, "fr"
+		}
+
+		switch {
+		case !ok && !toOk && track == targets[index]:
+			g.tracks = append(g.tracks, gridTrack{keyword: track})
+		case !ok || !toOk || unit != toUnit:
+			g.err = ErrGridUnits
+			return
+		default:
+			g.tracks = append(g.tracks, gridTrack{unit: unit, start: mag, target: toMag})
+		}
+	}
+
+	g.current = make([]string, len(g.tracks))
+	g.update(0)
+}
+
+// update interpolates the tracks by the eased progress.
+func (g *gridTracks) update(ease float64) {
+	if g.err != nil {
+		return
+	}
+
+	for index, track := range g.tracks {
+		if track.keyword != "" {
+			g.current[index] = track.keyword
+			continue
+		}
+
+		g.current[index] = fmt.Sprintf("%.2f%s", track.start+((track.target-track.start)*ease), track.unit)
+	}
+}
+
+// css writes the current tracks to the supplied writer.
+func (g *gridTracks) css(wc io.Writer) {
+	if g.err != nil || len(g.current) == 0 {
+		return
+	}
+
+	wc.Write([]byte(fmt.Sprintf("%s: %s;", g.property, strings.Join(g.current, " "))))
+}
+
+// frShares returns the fr of the computed pixel tracks targeting a size in
+// fr, being their share of the free space they were computed from, the sum
+// of their pixels, split by the sum of the fr of their targets.
+func frShares(starts, targets []string) map[int]float64 {
+	shares := make(map[int]float64)
+
+	var space, fr float64
+
+	for index, track := range starts {
+		mag, unit, ok := parseTrack(track)
+		toMag, toUnit, toOk := parseTrack(targets[index])
+
+		if ok && toOk && unit == "px" && toUnit == "fr" {
+			shares[index] = mag
+			space += mag
+			fr += toMag
+		}
+	}
+
+	for index, px := range shares {
+		shares[index] = 0
+		if space > 0 {
+			shares[index] = px / space * fr
+		}
+	}
+
+	return shares
+}
+
+// parseTrack returns the size and unit of a track, which may be in fr
+// besides the css units, and false for keyword and function tracks.
+func parseTrack(track string) (float64, string, bool) {
+	if lower := strings.ToLower(track); strings.HasSuffix(lower, "fr") {
+		mag, unit, err := govfx.ParseUnit(strings.TrimSuffix(lower, "fr"))
+		return mag, "fr", err == nil && unit == ""
+	}
+
+	mag, unit, err := govfx.ParseUnit(track)
+	return mag, unit, err == nil
+}
+
+//==============================================================================
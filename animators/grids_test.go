@@ -0,0 +1,46 @@
+package animators
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestGridTracks validates the behaviour of track lists, which interpolate
+// only when both hold the same number of tracks in matching units.
+func TestGridTracks(t *testing.T) {
+	tracks := []struct {
+		from, target string
+		err          error
+		css          string
+	}{
+		{"100px 1fr", "200px 3fr", nil, "grid-template-columns: 150.00px 2.00fr;"},
+		{"100px auto", "200px auto", nil, "grid-template-columns: 150.00px auto;"},
+		{"1fr 2fr", "1fr", ErrGridTracks, ""},
+		{"100px", "100px 1fr", ErrGridTracks, ""},
+		{"100px 1fr", "200px 50%", ErrGridUnits, ""},
+		{"auto 1fr", "min-content 1fr", ErrGridUnits, ""},
+	}
+
+	for _, track := range tracks {
+		var g gridTracks
+		g.init(nil, "grid-template-columns", track.from, track.target)
+		g.update(0.5)
+
+		var buf bytes.Buffer
+		g.css(&buf)
+
+		if g.err != track.err || buf.String() != track.css {
+			t.Errorf("Expected %q towards %q to write %q with %v but got %q with %v", track.from, track.target, track.css, track.err, buf.String(), g.err)
+		}
+	}
+}
+
+// TestFrShares validates the behaviour of the fr computed for pixel tracks
+// targeting fr, being their share of the space split by the target fr.
+func TestFrShares(t *testing.T) {
+	shares := frShares([]string{"200px", "600px", "100px"}, []string{"1fr", "3fr", "100px"})
+
+	if len(shares) != 2 || shares[0] != 1 || shares[1] != 3 {
+		t.Fatalf("Expected the pixel tracks to share 1fr and 3fr but got %v", shares)
+	}
+}
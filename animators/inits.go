@@ -8,17 +8,47 @@ func init() {
 
 	govfx.RegisterSequence("height", Height{})
 	govfx.RegisterSequence("width", Width{})
-	// govfx.RegisterSequence("translate-x", TranslateX{})
-	// govfx.RegisterSequence("translate-y", TranslateY{})
-	// govfx.RegisterSequence("scale-x", ScaleX{})
-	// govfx.RegisterSequence("scale-y", ScaleY{})
+	govfx.RegisterSequence("opacity", Opacity{})
+	govfx.RegisterSequence("translate-x", TranslateX{})
+	govfx.RegisterSequence("translate-y", TranslateY{})
+	govfx.RegisterSequence("translate-z", TranslateZ{})
+	govfx.RegisterSequence("rotate", Rotate{})
+	govfx.RegisterSequence("scale", Scale{})
+	govfx.RegisterSequence("numeric", Numeric{})
+	govfx.RegisterSequence("keyframes", Keyframed{})
+	govfx.RegisterSequence("border-radius", BorderRadius{})
+	govfx.RegisterSequence("box-shadow", BoxShadow{})
+	govfx.RegisterSequence("scroll-to", ScrollTo{})
+	govfx.RegisterSequence("counter", Counter{})
+	govfx.RegisterSequence("stroke-dashoffset", StrokeDashoffset{})
+	govfx.RegisterSequence("variable", Variable{})
+	govfx.RegisterSequence("linear-gradient", LinearGradient{})
+	govfx.RegisterSequence("filter", Filter{})
+	govfx.RegisterSequence("backdrop-filter", BackdropFilter{})
+	govfx.RegisterSequence("grid-template-columns", GridTemplateColumns{})
+	govfx.RegisterSequence("grid-template-rows", GridTemplateRows{})
+	govfx.RegisterSequence("letter-spacing", LetterSpacing{})
+	govfx.RegisterSequence("word-spacing", WordSpacing{})
+	govfx.RegisterSequence("font-size", FontSize{})
+	govfx.RegisterSequence("line-height", LineHeight{})
+	govfx.RegisterSequence("integer", Integer{})
+	govfx.RegisterSequence("fade-in", FadeIn{})
+	govfx.RegisterSequence("fade-out", FadeOut{})
 	// govfx.RegisterSequence("skew-x", SkewX{})
 	// govfx.RegisterSequence("skew-y", SkewY{})
-	// govfx.RegisterSequence("rotate", Rotate{})
 	// govfx.RegisterSequence("rotate-x", RotateX{})
 	// govfx.RegisterSequence("rotate-y", RotateY{})
 	// govfx.RegisterSequence("perspective", Perspective{})
-	//
-	// govfx.RegisterSequence("color", Color{})
-	// govfx.RegisterSequence("background-color", BackgroundColor{})
+
+	govfx.RegisterSequence("color", Color{})
+	govfx.RegisterSequence("background-color", BackgroundColor{})
+
+	govfx.RegisterEffect("fadeIn", FadeInEffect)
+	govfx.RegisterEffect("fadeOut", FadeOutEffect)
+	govfx.RegisterEffect("slideInLeft", SlideInEffect("x", -1))
+	govfx.RegisterEffect("slideInRight", SlideInEffect("x", 1))
+	govfx.RegisterEffect("slideInUp", SlideInEffect("y", 1))
+	govfx.RegisterEffect("slideInDown", SlideInEffect("y", -1))
+	govfx.RegisterEffect("zoomIn", ZoomInEffect)
+	govfx.RegisterEffect("zoomOut", ZoomOutEffect)
 }
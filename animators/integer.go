@@ -0,0 +1,65 @@
+package animators
+
+import (
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/influx6/govfx"
+)
+
+//==============================================================================
+
+// Integer defines a sequence for animating any css property only accepting
+// integers, eg z-index, order or column-count, whose value is rounded to the
+// nearest integer on each frame. A start of auto or none is read as 0. As the
+// rounded value stays the same across many frames, elements animated only by
+// Integer sequences are written just when their value changes.
+type Integer struct {
+	Property string       `govfx:"property"`
+	From     string       `govfx:"from"`
+	Target   int          `govfx:"value"`
+	Easing   string       `govfx:"easing"`
+	Easer    govfx.Easing `govfx:"easer"`
+
+	start   float64
+	current int
+}
+
+// Init initializes the property with the provided element for animation.
+func (n *Integer) Init(elem govfx.Elemental) {
+	if n.Easer == nil {
+		n.Easer = govfx.GetEasing(n.Easing)
+	}
+
+	n.start = 0
+
+	from := n.From
+	if from == "" {
+		from, _, _ = elem.Read(n.Property, "")
+	}
+
+	if magnitude, _, err := govfx.ParseUnit(from); err == nil {
+		n.start = math.Round(magnitude)
+	}
+
+	n.current = int(n.start)
+}
+
+// Discrete returns true as the output of the sequence only changes in whole
+// steps.
+func (n *Integer) Discrete() bool {
+	return true
+}
+
+// Update contains the update operations for the property.
+func (n *Integer) Update(delta float64, timeline float64) {
+	n.current = int(math.Round(n.start + ((float64(n.Target) - n.start) * n.Easer.Ease(timeline))))
+}
+
+// CSS writes the css output to the supplied writer.
+func (n *Integer) CSS(wc io.Writer) {
+	wc.Write([]byte(fmt.Sprintf("%s: %d;", n.Property, n.current)))
+}
+
+//==============================================================================
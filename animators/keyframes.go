@@ -0,0 +1,135 @@
+package animators
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/influx6/govfx"
+)
+
+//==============================================================================
+
+// Keyframe defines a single stop of a keyframed animation, where Offset is
+// the position(0..1) of the stop within the timeline and Easing the easing
+// used from this stop to the next, resolved by govfx.ParseEasing, eg
+// easeOutElastic, cubic-bezier(0.25, 0.1, 0.25, 1) or steps(2).
+type Keyframe struct {
+	Offset float64
+	Value  string
+	Easing string
+}
+
+// Keyframed defines a sequence for animating a css property through multiple
+// stops, like the css @keyframes rule. Colors, unit values and any other
+// values are interpolated between the two stops surrounding the current
+// position within the timeline. Missing stops at the start or end of the
+// timeline take the current value of the property. Stops without their own
+// easing use the easing of the sequence, while the easing of a stop eases the
+// progress(0..1) through its own segment.
+type Keyframed struct {
+	Property string       `govfx:"property"`
+	Stops    []Keyframe   `govfx:"stops"`
+	Easing   string       `govfx:"easing"`
+	Easer    govfx.Easing `govfx:"easer"`
+
+	stops   []Keyframe
+	easers  []govfx.Easing
+	current string
+	err     error
+}
+
+// NewKeyframed returns a new Keyframed sequence animating the giving property
+// through the provided stops.
+func NewKeyframed(property string, stops []Keyframe) govfx.Sequence {
+	return &Keyframed{
+		Property: property,
+		Stops:    stops,
+	}
+}
+
+// Init initializes the keyframes with the provided element for animation.
+func (k *Keyframed) Init(elem govfx.Elemental) {
+	if k.Easer == nil {
+		k.Easer = govfx.GetEasing(k.Easing)
+	}
+
+	k.current, _, _ = elem.Read(k.Property, "")
+
+	k.stops = append([]Keyframe(nil), k.Stops...)
+	sort.SliceStable(k.stops, func(i, j int) bool {
+		return k.stops[i].Offset < k.stops[j].Offset
+	})
+
+	if len(k.stops) == 0 || k.stops[0].Offset > 0 {
+		k.stops = append([]Keyframe{{Offset: 0, Value: k.current}}, k.stops...)
+	}
+
+	if k.stops[len(k.stops)-1].Offset < 1 {
+		k.stops = append(k.stops, Keyframe{Offset: 1, Value: k.current})
+	}
+
+	k.easers = make([]govfx.Easing, len(k.stops))
+	k.err = nil
+
+	for index, stop := range k.stops {
+		if stop.Easing == "" {
+			k.easers[index] = k.Easer
+			continue
+		}
+
+		easer, err := govfx.ParseEasing(stop.Easing)
+		if err != nil {
+			k.err = err
+			easer = k.Easer
+		}
+
+		k.easers[index] = easer
+	}
+}
+
+// Err returns the error of the first stop whose easing could not be resolved,
+// those stops use the easing of the sequence instead.
+func (k *Keyframed) Err() error {
+	return k.err
+}
+
+// Transitionable returns false, as a transition can not pass through the
+// stops of the keyframes.
+func (k *Keyframed) Transitionable() bool {
+	return false
+}
+
+// Update contains the update operations for the keyframes.
+func (k *Keyframed) Update(delta float64, timeline float64) {
+	last := len(k.stops) - 1
+
+	index := 0
+	for index < last-1 && timeline >= k.stops[index+1].Offset {
+		index++
+	}
+
+	from, to := k.stops[index], k.stops[index+1]
+
+	local := 1.0
+	if span := to.Offset - from.Offset; span > 0 {
+		local = (timeline - from.Offset) / span
+	}
+
+	if local < 0 {
+		local = 0
+	}
+
+	if local > 1 {
+		local = 1
+	}
+
+	k.current = govfx.LerpValue(from.Value, to.Value, k.easers[index].Ease(local))
+}
+
+// CSS writes the css output to the supplied writer
+func (k *Keyframed) CSS(wc io.Writer) {
+	wc.Write([]byte(fmt.Sprintf("%s: %s;", k.Property, k.current)))
+}
+
+//==============================================================================
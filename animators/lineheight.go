@@ -0,0 +1,122 @@
+package animators
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/influx6/govfx"
+)
+
+//==============================================================================
+
+// normalLineHeight defines the multiplier a line-height of normal is read as,
+// which approximates the normal line-height of most fonts.
+const normalLineHeight = 1.2
+
+// LineHeight defines a sequence for animating the line-height of an element
+// towards its Value, written in the form of the Value, being a unitless
+// multiplier, eg 1.5, a length, eg 24px or 1.5em, or a percentage, eg 150%.
+// As line-heights are usually computed in px, the start is converted into the
+// form of the Value by the font-size of the element, where multipliers and em
+// values count in font-sizes and percentages in hundredths of it, while a
+// line-height of normal is read as the multiplier 1.2. A start which can not
+// be converted, eg for an element without a font-size, keeps its magnitude.
+type LineHeight struct {
+	From   string       `govfx:"from"`
+	Value  string       `govfx:"value"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	unit    string
+	start   float64
+	target  float64
+	current float64
+	err     error
+}
+
+// Init initializes the line-height with the provided element for animation.
+func (l *LineHeight) Init(elem govfx.Elemental) {
+	if l.Easer == nil {
+		l.Easer = govfx.GetEasing(l.Easing)
+	}
+
+	l.target, l.unit, l.err = govfx.ParseUnit(l.Value)
+	if l.err != nil {
+		return
+	}
+
+	start := l.From
+	if start == "" {
+		start, _, _ = elem.Read("line-height", "")
+	}
+
+	fontSize, _, _ := elem.ReadFloat("font-size", "")
+
+	l.start = lineHeightIn(start, l.unit, fontSize, l.target)
+	l.current = l.start
+}
+
+// Err returns the error of the Value given, if it is not a valid line-height.
+func (l *LineHeight) Err() error {
+	return l.err
+}
+
+// Update contains the update operations for the line-height.
+func (l *LineHeight) Update(delta float64, timeline float64) {
+	l.current = l.start + ((l.target - l.start) * l.Easer.Ease(timeline))
+}
+
+// CSS writes the css output to the supplied writer.
+func (l *LineHeight) CSS(wc io.Writer) {
+	if l.err != nil {
+		return
+	}
+
+	wc.Write([]byte(fmt.Sprintf("line-height: %.2f%s;", l.current, l.unit)))
+}
+
+//==============================================================================
+
+// lineHeightIn returns the line-height value converted into the giving unit
+// by the font-size, returning the fallback if the value is malformed.
+func lineHeightIn(value string, unit string, fontSize float64, fallback float64) float64 {
+	if value = strings.TrimSpace(value); value == "" || strings.EqualFold(value, "normal") {
+		value = govfx.FormatUnit(normalLineHeight, "")
+	}
+
+	mag, vunit, err := govfx.ParseUnit(value)
+	if err != nil {
+		return fallback
+	}
+
+	if vunit == unit || fontSize <= 0 {
+		return mag
+	}
+
+	var px float64
+
+	switch vunit {
+	case "", "em":
+		px = mag * fontSize
+	case "%":
+		px = mag * fontSize / 100
+	case "px":
+		px = mag
+	default:
+		return mag
+	}
+
+	switch unit {
+	case "", "em":
+		return px / fontSize
+	case "%":
+		return px * 100 / fontSize
+	case "px":
+		return px
+	}
+
+	return mag
+}
+
+//==============================================================================
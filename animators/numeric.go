@@ -0,0 +1,131 @@
+package animators
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/influx6/govfx"
+	"honnef.co/go/js/dom"
+)
+
+//==============================================================================
+
+// Numeric defines a sequence for animating any css property holding a single
+// numeric value, eg margin-left, font-size, border-radius, top or left.
+// When no unit is provided, the unit of the property's current value is used.
+// A Relative operator of +=, -= or *= applies the Target to the current
+// value, eg a Target of 20 with += animates 20 beyond it.
+// When optimized, pixel animations of left and top are written as a translate
+// from the current position added to the translation of the element instead,
+// where the final frames write the property itself. The optional Clamp bounds the written
+// value, eg [2]float64{0, 1} for an opacity. A ValueFn takes precedence over
+// the Target, see Width.
+type Numeric struct {
+	Property string                         `govfx:"property"`
+	From     string                         `govfx:"from"`
+	Target   float64                        `govfx:"value"`
+	ValueFn  func(int, dom.Element) float64 `govfx:"value-fn"`
+	Unit     string                         `govfx:"unit"`
+	Relative string                         `govfx:"relative"`
+	Clamp    [2]float64                     `govfx:"clamp"`
+	Easing   string                         `govfx:"easing"`
+	Easer    govfx.Easing                   `govfx:"easer"`
+
+	start     float64
+	target    float64
+	current   float64
+	timeline  float64
+	offset    float64
+	optimized bool
+}
+
+// NewNumeric returns a new Numeric sequence animating the giving property
+// towards the target in the provided unit.
+func NewNumeric(property string, target float64, unit string) govfx.Sequence {
+	return &Numeric{
+		Property: property,
+		Target:   target,
+		Unit:     unit,
+	}
+}
+
+// Init initializes the property with the provided element for animation.
+func (n *Numeric) Init(elem govfx.Elemental) {
+	if n.Easer == nil {
+		n.Easer = govfx.GetEasing(n.Easing)
+	}
+
+	n.start = 0
+
+	from := n.From
+	if from == "" {
+		from, _, _ = elem.Read(n.Property, "")
+	}
+
+	if magnitude, unit, err := govfx.ParseUnit(from); err == nil {
+		n.start = magnitude
+
+		if n.Unit == "" {
+			n.Unit = unit
+		}
+	}
+
+	n.target = govfx.ResolveRelative(n.start, targetOf(elem, n.Target, n.ValueFn), n.Relative)
+	n.current = n.start
+	n.timeline = 0
+
+	if axis := n.axis(); axis >= 0 {
+		n.offset = readTranslation(elem, axis, "px")
+	}
+}
+
+// axis returns the translation axis(0: x, 1: y) the optimized sequence writes
+// its property as, else -1 when it writes the property itself.
+func (n *Numeric) axis() int {
+	if !n.optimized || n.Unit != "px" {
+		return -1
+	}
+
+	switch n.Property {
+	case "left":
+		return 0
+	case "top":
+		return 1
+	}
+
+	return -1
+}
+
+// Optimize switches the sequence to writing left and top as a translate.
+func (n *Numeric) Optimize() {
+	n.optimized = true
+}
+
+// Update contains the update operations for the property.
+func (n *Numeric) Update(delta float64, timeline float64) {
+	n.timeline = timeline
+	n.current = n.start + ((n.target - n.start) * n.Easer.Ease(timeline))
+}
+
+// CSS writes the css output to the supplied writer
+func (n *Numeric) CSS(wc io.Writer) {
+	current := govfx.ClampValue(n.current, n.Clamp)
+
+	if axis := n.axis(); axis >= 0 {
+		fn := [2]string{"translateX", "translateY"}[axis]
+
+		// The final frames move the element by its property, restoring its
+		// own translation.
+		if n.timeline <= 0 || n.timeline >= 1 {
+			wc.Write([]byte(fmt.Sprintf("%s: %.2f%s; transform: %s(%.2fpx);", n.Property, current, n.Unit, fn, n.offset)))
+			return
+		}
+
+		wc.Write([]byte(fmt.Sprintf("transform: %s(%.2fpx);", fn, n.offset+current-n.start)))
+		return
+	}
+
+	wc.Write([]byte(fmt.Sprintf("%s: %.2f%s;", n.Property, current, n.Unit)))
+}
+
+//==============================================================================
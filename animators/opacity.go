@@ -1 +1,68 @@
 package animators
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/influx6/govfx"
+)
+
+//==============================================================================
+
+// Opacity provides animation sequencing for opacity properties, it uses float
+// values between 0 and 1. The written opacity is clamped to 0 and 1 unless a
+// different Clamp is provided, allowing overshooting easings like elastic to
+// be used safely.
+type Opacity struct {
+	From     string       `govfx:"from"`
+	Target   float64      `govfx:"value"`
+	Relative string       `govfx:"relative"`
+	Clamp    [2]float64   `govfx:"clamp"`
+	Easing   string       `govfx:"easing"`
+	Easer    govfx.Easing `govfx:"easer"`
+
+	start   float64
+	target  float64
+	current float64
+
+	elem govfx.Elemental
+}
+
+// Init initializes the opacity property with the provided element for
+// animation. Elements without an explicit opacity start at 1.
+func (o *Opacity) Init(elem govfx.Elemental) {
+	o.elem = elem
+
+	if o.Easer == nil {
+		o.Easer = govfx.GetEasing(o.Easing)
+	}
+
+	if o.Clamp == [2]float64{} {
+		o.Clamp = [2]float64{0, 1}
+	}
+
+	o.start = 1
+
+	if o.From != "" {
+		o.start = govfx.ParseFloat(o.From)
+	} else if op, _, ok := elem.Read("opacity", ""); ok && strings.TrimSpace(op) != "" {
+		o.start = govfx.ParseFloat(op)
+	}
+
+	o.target = govfx.ResolveRelative(o.start, o.Target, o.Relative)
+	o.current = o.start
+}
+
+// Update contains the update operations for the opacity property.
+func (o *Opacity) Update(delta float64, timeline float64) {
+	easer := o.Easer.Ease(timeline)
+	o.current = o.start + ((o.target - o.start) * easer)
+}
+
+// CSS writes the css output to the supplied writer
+func (o *Opacity) CSS(wc io.Writer) {
+	wc.Write([]byte(fmt.Sprintf("opacity: %.2f;", govfx.ClampValue(o.current, o.Clamp))))
+}
+
+//==============================================================================
@@ -1,250 +1,103 @@
 package animators
 
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/influx6/govfx"
+)
+
 //==============================================================================
 
-// // RotateY defines a sequence for animating css Rotate y-axes properties.
-// type RotateY struct {
-// 	Value  int    `govfx:"value"`
-// 	Easing string `govfx:"easing"`
-// }
-//
-// // Init returns the initial writers for the sequence.
-// func (t *RotateY) Init(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "rotate")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var y float64
-//
-// 			if govfx.IsMatrix(transform) {
-// 				mx, _ := govfx.ToMatrix2D(transform)
-// 				y = mx.RotationY
-// 			} else if govfx.IsRotation(transform) {
-// 				mx, _ := govfx.ToRotation(transform)
-// 				y = mx.Angle
-// 			} else {
-// 				y = 0
-// 			}
-//
-// 			transform = fmt.Sprintf("rotateY(%.0fdeg)", y)
-//
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// // Next returns the writers for the next sequence.
-// func (t *RotateY) Next(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	easing := govfx.GetEasing(t.Easing)
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "rotate")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var y float64
-//
-// 			if govfx.IsMatrix(transform) {
-// 				mx, _ := govfx.ToMatrix2D(transform)
-// 				y = mx.RotationY
-// 			} else if govfx.IsRotation(transform) {
-// 				mx, _ := govfx.ToRotation(transform)
-// 				y = mx.Angle
-// 			}
-//
-// 			yd := float64(t.Value) - y
-//
-// 			yn := easing.Ease(govfx.EaseConfig{
-// 				Stat:         stats,
-// 				CurrentValue: y,
-// 				DeltaValue:   yd,
-// 			})
-//
-// 			e.EraseMore("transform", "matrix", false)
-//
-// 			transform = fmt.Sprintf("rotateY(%.0fdeg)", yn)
-//
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// //==============================================================================
-//
-// // RotateX defines a sequence for animating css Rotate x-axes properties.
-// type RotateX struct {
-// 	Value  int    `govfx:"value"`
-// 	Easing string `govfx:"easing"`
-// }
-//
-// // Init returns the initial writers for the sequence.
-// func (t *RotateX) Init(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "rotate")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var x float64
-//
-// 			if govfx.IsMatrix(transform) {
-// 				mx, _ := govfx.ToMatrix2D(transform)
-// 				x = mx.RotationX
-// 			} else if govfx.IsRotation(transform) {
-// 				mx, _ := govfx.ToRotation(transform)
-// 				x = mx.Angle
-// 			} else {
-// 				x = 0
-// 			}
-//
-// 			transform = fmt.Sprintf("rotateX(%.0fdeg)", x)
-//
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// // Next returns the writers for the next sequence.
-// func (t *RotateX) Next(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	easing := govfx.GetEasing(t.Easing)
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "rotate")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var x float64
-//
-// 			if govfx.IsMatrix(transform) {
-// 				mx, _ := govfx.ToMatrix2D(transform)
-// 				x = mx.RotationX
-// 			} else if govfx.IsRotation(transform) {
-// 				mx, _ := govfx.ToRotation(transform)
-// 				x = mx.Angle
-// 			}
-//
-// 			xd := float64(t.Value) - x
-//
-// 			xn := easing.Ease(govfx.EaseConfig{
-// 				Stat:         stats,
-// 				CurrentValue: x,
-// 				DeltaValue:   xd,
-// 			})
-//
-// 			e.EraseMore("transform", "matrix", false)
-// 			transform = fmt.Sprintf("rotateX(%.0fdeg)", xn)
-//
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// //==============================================================================
-//
-// // Rotate defines a sequence for animating css Rotate x-axes properties.
-// type Rotate struct {
-// 	Value  int    `govfx:"value"`
-// 	Easing string `govfx:"easing"`
-// }
-//
-// // Init returns the initial writers for the sequence.
-// func (t *Rotate) Init(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "rotate")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var r float64
-//
-// 			if govfx.IsSimpleRotation(transform) {
-// 				mx, _ := govfx.ToRotation(transform)
-// 				r = mx.Angle
-// 			} else {
-// 				r = 0
-// 			}
-//
-// 			transform = fmt.Sprintf("rotate(%.0fdeg)", r)
-//
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// // Next returns the writers for the next sequence.
-// func (t *Rotate) Next(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	easing := govfx.GetEasing(t.Easing)
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "rotate")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var r float64
-//
-// 			if govfx.IsSimpleRotation(transform) {
-// 				mx, _ := govfx.ToRotation(transform)
-// 				r = mx.Angle
-// 			} else {
-// 				r = 0
-// 			}
-//
-// 			rd := float64(t.Value) - r
-//
-// 			rn := easing.Ease(govfx.EaseConfig{
-// 				Stat:         stats,
-// 				CurrentValue: r,
-// 				DeltaValue:   rd,
-// 			})
-//
-// 			e.EraseMore("transform", "matrix", false)
-//
-// 			transform = fmt.Sprintf("rotate(%.0fdeg)", rn)
-//
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
+// Rotate defines a sequence for animating css rotate properties. Its target
+// is provided in degrees and may be negative or go beyond a full turn. The
+// Axis of x or y rotates the element in 3d around that axis, best viewed
+// through the Perspective of the stat, else it rotates within the page. The
+// optional Origin sets the transform-origin the element rotates around, eg
+// left center to swing it like a door, which is written as is on each frame.
+// The rotation is merged into the transform of the element, keeping its
+// translation and scale.
+type Rotate struct {
+	From     string       `govfx:"from"`
+	Target   float64      `govfx:"value"`
+	Relative string       `govfx:"relative"`
+	Axis     string       `govfx:"axis"`
+	Origin   string       `govfx:"origin"`
+	Easing   string       `govfx:"easing"`
+	Easer    govfx.Easing `govfx:"easer"`
+
+	start   float64
+	target  float64
+	current float64
+}
+
+// Init initializes the rotation with the provided element for animation.
+func (r *Rotate) Init(elem govfx.Elemental) {
+	if r.Easer == nil {
+		r.Easer = govfx.GetEasing(r.Easing)
+	}
+
+	r.start = govfx.ParseFloat(r.From)
+	if r.From == "" {
+		r.start = readRotation(elem, r.function())
+	}
+	r.target = govfx.ResolveRelative(r.start, r.Target, r.Relative)
+	r.current = r.start
+}
+
+// Update contains the update operations for the rotation.
+func (r *Rotate) Update(delta float64, timeline float64) {
+	r.current = r.start + ((r.target - r.start) * r.Easer.Ease(timeline))
+}
+
+// CSS writes the css output to the supplied writer
+func (r *Rotate) CSS(wc io.Writer) {
+	wc.Write([]byte(fmt.Sprintf("transform: %s(%.2fdeg);", r.function(), r.current)))
+
+	if r.Origin != "" {
+		wc.Write([]byte(fmt.Sprintf(" transform-origin: %s;", r.Origin)))
+	}
+}
+
+// function returns the name of the transform function rotating around the
+// axis of the rotation.
+func (r *Rotate) function() string {
+	switch strings.ToLower(r.Axis) {
+	case "x":
+		return "rotateX"
+	case "y":
+		return "rotateY"
+	}
+
+	return "rotate"
+}
+
+//==============================================================================
+
+// readRotation returns the current rotation in degrees of the element's
+// transform, reading either from the named rotate function or, for rotations
+// within the page, from its transformation matrix.
+func readRotation(elem govfx.Elemental, function string) float64 {
+	if val, _, ok := elem.Read("transform", function); ok {
+		if rt, err := govfx.ToRotation(val); err == nil {
+			return rt.Angle
+		}
+	}
+
+	if function != "rotate" {
+		return 0
+	}
+
+	// A matrix only holds the rotation within a single turn, which is as much
+	// as the computed style of an element reports.
+	if val, _, ok := elem.Read("transform", "matrix"); ok {
+		if _, _, rotate, _, _, _, err := govfx.DecomposeMatrix(val); err == nil {
+			return rotate
+		}
+	}
+
+	return 0
+}
+
 //==============================================================================
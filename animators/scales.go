@@ -1,135 +1,112 @@
 package animators
 
+import (
+	"fmt"
+	"io"
+
+	"github.com/influx6/govfx"
+)
+
+//==============================================================================
+
+// Scale defines a sequence for animating css scale properties, where both
+// axes are animated independently towards their targets. The optional Origin
+// sets the transform-origin the element scales from, see Rotate. The scale is
+// merged into the transform of the element, keeping its translation and
+// rotation.
+type Scale struct {
+	From   string       `govfx:"from"`
+	X      float64      `govfx:"x"`
+	Y      float64      `govfx:"y"`
+	Origin string       `govfx:"origin"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	start   govfx.Scale
+	current govfx.Scale
+}
+
+// Init initializes the scale with the provided element for animation.
+func (s *Scale) Init(elem govfx.Elemental) {
+	if s.Easer == nil {
+		s.Easer = govfx.GetEasing(s.Easing)
+	}
+
+	if s.From != "" {
+		s.start.X = govfx.ParseFloat(s.From)
+		s.start.Y = s.start.X
+	} else {
+		s.start = readScale(elem)
+	}
+	s.current = s.start
+}
+
+// Update contains the update operations for the scale.
+func (s *Scale) Update(delta float64, timeline float64) {
+	ease := s.Easer.Ease(timeline)
+
+	s.current.X = s.start.X + ((s.X - s.start.X) * ease)
+	s.current.Y = s.start.Y + ((s.Y - s.start.Y) * ease)
+}
+
+// CSS writes the css output to the supplied writer
+func (s *Scale) CSS(wc io.Writer) {
+	x := fmt.Sprintf("%.2f", s.current.X)
+	y := fmt.Sprintf("%.2f", s.current.Y)
+
+	if x == y {
+		wc.Write([]byte(fmt.Sprintf("transform: scale(%s);", x)))
+	} else {
+		wc.Write([]byte(fmt.Sprintf("transform: scale(%s, %s);", x, y)))
+	}
+
+	if s.Origin != "" {
+		wc.Write([]byte(fmt.Sprintf(" transform-origin: %s;", s.Origin)))
+	}
+}
+
 //==============================================================================
 
-// // ScaleY defines a sequence for animating css Scale y-axes properties.
-// type ScaleY struct {
-// 	Value  float64 `govfx:"value"`
-// 	Easing string  `govfx:"easing"`
-// }
-//
-// // Init returns the initial writers for the sequence.
-// func (t *ScaleY) Init(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	for _, elem := range elems {
-// 		func(e govfx.Elemental) {
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", "scaleY(1.0)", false)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// // Next returns the writers for the next sequence.
-// func (t *ScaleY) Next(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	easing := govfx.GetEasing(t.Easing)
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "scale")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var y float64
-//
-// 			if govfx.IsMatrix(transform) {
-// 				mx, _ := govfx.ToMatrix2D(transform)
-// 				y = mx.ScaleY
-// 			} else if govfx.IsScale(transform) {
-// 				mx, _ := govfx.ToScale(transform)
-// 				y = mx.Y
-// 			}
-//
-// 			yd := float64(t.Value) - y
-//
-// 			yn := easing.Ease(govfx.EaseConfig{
-// 				Stat:         stats,
-// 				CurrentValue: y,
-// 				DeltaValue:   yd,
-// 			})
-//
-// 			transform = fmt.Sprintf("scaleY(%.2f)", yn)
-// 			e.EraseMore("transform", "matrix", false)
-//
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// //==============================================================================
-//
-// // ScaleX defines a sequence for animating css Scale x-axes properties.
-// type ScaleX struct {
-// 	Value  float64 `govfx:"value"`
-// 	Easing string  `govfx:"easing"`
-// }
-//
-// // Init returns the initial writers for the sequence.
-// func (t *ScaleX) Init(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	for _, elem := range elems {
-// 		func(e govfx.Elemental) {
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", "scaleX(1.0)", false)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// // Next returns the writers for the next sequence.
-// func (t *ScaleX) Next(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	easing := govfx.GetEasing(t.Easing)
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "scale")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var x float64
-//
-// 			if govfx.IsMatrix(transform) {
-// 				mx, _ := govfx.ToMatrix2D(transform)
-// 				x = mx.ScaleX
-// 			} else if govfx.IsScale(transform) {
-// 				mx, _ := govfx.ToScale(transform)
-// 				x = mx.X
-// 			}
-//
-// 			xd := float64(t.Value) - x
-//
-// 			xn := easing.Ease(govfx.EaseConfig{
-// 				Stat:         stats,
-// 				CurrentValue: x,
-// 				DeltaValue:   xd,
-// 			})
-//
-// 			e.EraseMore("transform", "matrix", false)
-//
-// 			transform = fmt.Sprintf("scaleX(%.2f)", xn)
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.WriteMore("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
+// readScale returns the current scale of the element's transform, reading
+// either from its scale functions or from its transformation matrix. A scale
+// of 1 is returned for axes which are not scaled.
+func readScale(elem govfx.Elemental) govfx.Scale {
+	sc := govfx.Scale{X: 1, Y: 1}
+
+	if val, _, ok := elem.Read("transform", "scale"); ok {
+		if vs, err := govfx.ToScale(val); err == nil {
+			return *vs
+		}
+	}
+
+	var scaled bool
+
+	if val, _, ok := elem.Read("transform", "scaleX"); ok {
+		if vs, err := govfx.ToScale(val); err == nil {
+			sc.X = vs.X
+			scaled = true
+		}
+	}
+
+	if val, _, ok := elem.Read("transform", "scaleY"); ok {
+		if vs, err := govfx.ToScale(val); err == nil {
+			sc.Y = vs.Y
+			scaled = true
+		}
+	}
+
+	if scaled {
+		return sc
+	}
+
+	// The decomposed scale holds true for rotated and skewed elements as well.
+	if val, _, ok := elem.Read("transform", "matrix"); ok {
+		if _, _, _, x, y, _, err := govfx.DecomposeMatrix(val); err == nil {
+			sc.X, sc.Y = x, y
+		}
+	}
+
+	return sc
+}
 
 //==============================================================================
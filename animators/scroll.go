@@ -0,0 +1,78 @@
+package animators
+
+import (
+	"io"
+	"math"
+
+	"github.com/influx6/govfx"
+	"honnef.co/go/js/dom"
+)
+
+//==============================================================================
+
+// ScrollTo defines a sequence for animating the scroll position of its Target
+// towards the Top and Left offsets in pixels, where a nil Target scrolls the
+// window. The scroll position is set directly every frame, hence the sequence
+// writes no css and can run on any element, including the one it scrolls.
+type ScrollTo struct {
+	Top    float64      `govfx:"top"`
+	Left   float64      `govfx:"left"`
+	Target dom.Element  `govfx:"target"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	startTop  float64
+	startLeft float64
+}
+
+// Init reads the current scroll position of the target.
+func (s *ScrollTo) Init(elem govfx.Elemental) {
+	if s.Easer == nil {
+		s.Easer = govfx.GetEasing(s.Easing)
+	}
+
+	if s.Target == nil {
+		win := govfx.Window()
+		s.startTop = float64(win.ScrollY())
+		s.startLeft = float64(win.ScrollX())
+		return
+	}
+
+	target := s.Target.Underlying()
+	s.startTop = target.Get("scrollTop").Float()
+	s.startLeft = target.Get("scrollLeft").Float()
+}
+
+// Effectful returns true, as the scroll position is set outside of the css of the
+// element.
+func (s *ScrollTo) Effectful() bool {
+	return true
+}
+
+// Transitionable returns false, as scroll positions can not be transitioned.
+func (s *ScrollTo) Transitionable() bool {
+	return false
+}
+
+// Update scrolls the target to its position at the current point of the
+// timeline.
+func (s *ScrollTo) Update(delta float64, timeline float64) {
+	ease := s.Easer.Ease(timeline)
+
+	top := s.startTop + ((s.Top - s.startTop) * ease)
+	left := s.startLeft + ((s.Left - s.startLeft) * ease)
+
+	if s.Target == nil {
+		govfx.Window().ScrollTo(int(math.Floor(left+0.5)), int(math.Floor(top+0.5)))
+		return
+	}
+
+	target := s.Target.Underlying()
+	target.Set("scrollTop", top)
+	target.Set("scrollLeft", left)
+}
+
+// CSS writes no css, as the scroll position is not a style of the element.
+func (s *ScrollTo) CSS(wc io.Writer) {}
+
+//==============================================================================
@@ -0,0 +1,129 @@
+package animators
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/influx6/govfx"
+)
+
+//==============================================================================
+
+// BoxShadow defines a sequence for animating the box-shadow of an element
+// towards its target shadow, eg 0px 8px 16px rgba(0,0,0,0.3). The offsets,
+// blur and spread of the shadow are interpolated alongside its color, where a
+// start or target of none is treated as a transparent shadow with all its
+// lengths at 0. Only the first of multiple comma separated shadows is
+// animated.
+type BoxShadow struct {
+	From   string       `govfx:"from"`
+	Target string       `govfx:"value"`
+	HSL    bool         `govfx:"hsl"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	start   shadow
+	target  shadow
+	current shadow
+}
+
+// Init initializes the shadow with the provided element for animation.
+func (b *BoxShadow) Init(elem govfx.Elemental) {
+	if b.Easer == nil {
+		b.Easer = govfx.GetEasing(b.Easing)
+	}
+
+	color := readColor(elem, "color", "rgb(0,0,0)")
+
+	start := b.From
+	if start == "" {
+		start, _, _ = elem.Read("box-shadow", "")
+	}
+
+	b.start = parseShadow(start, color)
+	b.target = parseShadow(b.Target, color)
+
+	// A shadow fading in or out keeps the inset of its visible end.
+	if b.start.none {
+		b.start.inset = b.target.inset
+	}
+
+	if b.target.none {
+		b.target.inset = b.start.inset
+	}
+
+	b.current = b.start
+}
+
+// Update contains the update operations for the shadow.
+func (b *BoxShadow) Update(delta float64, timeline float64) {
+	ease := b.Easer.Ease(timeline)
+
+	for index := range b.current.lengths {
+		b.current.lengths[index] = govfx.LerpValue(b.start.lengths[index], b.target.lengths[index], ease)
+	}
+
+	b.current.color = govfx.LerpColor(b.start.color, b.target.color, ease, colorMode(b.HSL))
+
+	b.current.inset = b.start.inset
+	if ease >= 0.5 {
+		b.current.inset = b.target.inset
+	}
+}
+
+// CSS writes the css output to the supplied writer.
+func (b *BoxShadow) CSS(wc io.Writer) {
+	value := strings.Join(b.current.lengths[:], " ") + " " + b.current.color
+
+	if b.current.inset {
+		value = "inset " + value
+	}
+
+	wc.Write([]byte(fmt.Sprintf("box-shadow: %s;", value)))
+}
+
+//==============================================================================
+
+// shadow defines the parts of a single box-shadow.
+type shadow struct {
+	none    bool
+	inset   bool
+	color   string
+	lengths [4]string
+}
+
+// parseShadow parses the first shadow of a box-shadow value into its offsets,
+// blur, spread, color and inset, in any order css allows them. Lengths not
+// provided default to 0 and a missing color to the giving color, while an
+// empty or none value returns a transparent shadow.
+func parseShadow(value string, color string) shadow {
+	sh := shadow{color: color, lengths: [4]string{"0px", "0px", "0px", "0px"}}
+
+	value = strings.TrimSpace(govfx.SplitTopLevel(value, ',')[0])
+	if value == "" || strings.EqualFold(value, "none") {
+		sh.none = true
+		sh.color = "transparent"
+		return sh
+	}
+
+	var lengths int
+
+	for _, part := range govfx.SplitTopLevel(value, ' ') {
+		switch {
+		case strings.EqualFold(part, "inset"):
+			sh.inset = true
+		case govfx.IsColor(part):
+			sh.color = part
+		default:
+			if _, _, err := govfx.ParseUnit(part); err == nil && lengths < len(sh.lengths) {
+				sh.lengths[lengths] = part
+				lengths++
+			}
+		}
+	}
+
+	return sh
+}
+
+//==============================================================================
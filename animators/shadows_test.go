@@ -0,0 +1,23 @@
+package animators
+
+import "testing"
+
+// TestParseShadow validates the behaviour of parsing box-shadow values, whose
+// inset and color may come in any order, where only the first of multiple
+// shadows is read.
+func TestParseShadow(t *testing.T) {
+	shadows := map[string]shadow{
+		"inset 0 2px 4px #f00":             {inset: true, color: "#f00", lengths: [4]string{"0", "2px", "4px", "0px"}},
+		"#f00 0 2px 4px inset":             {inset: true, color: "#f00", lengths: [4]string{"0", "2px", "4px", "0px"}},
+		"1px 1px 2px 3px":                  {color: "#000", lengths: [4]string{"1px", "1px", "2px", "3px"}},
+		"1px 2px #00f, inset 3px 4px #0f0": {color: "#00f", lengths: [4]string{"1px", "2px", "0px", "0px"}},
+		"none":                             {none: true, color: "transparent", lengths: [4]string{"0px", "0px", "0px", "0px"}},
+		"":                                 {none: true, color: "transparent", lengths: [4]string{"0px", "0px", "0px", "0px"}},
+	}
+
+	for value, expected := range shadows {
+		if sh := parseShadow(value, "#000"); sh != expected {
+			t.Errorf("Expected parseShadow(%q) to be %+v but got %+v", value, expected, sh)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package animators
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/influx6/govfx"
+)
+
+//==============================================================================
+
+// LetterSpacing defines a sequence for animating the letter-spacing of an
+// element towards the Value in its Unit, which defaults to px. A computed
+// letter-spacing of normal is read as 0.
+type LetterSpacing struct {
+	From   string       `govfx:"from"`
+	Value  float64      `govfx:"value"`
+	Unit   string       `govfx:"unit"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	spacing spacing
+}
+
+// Init initializes the letter-spacing with the provided element for
+// animation.
+func (l *LetterSpacing) Init(elem govfx.Elemental) {
+	if l.Easer == nil {
+		l.Easer = govfx.GetEasing(l.Easing)
+	}
+
+	l.spacing.init(elem, "letter-spacing", l.From, l.Value, l.Unit)
+}
+
+// Update contains the update operations for the letter-spacing.
+func (l *LetterSpacing) Update(delta float64, timeline float64) {
+	l.spacing.update(l.Easer.Ease(timeline))
+}
+
+// CSS writes the css output to the supplied writer.
+func (l *LetterSpacing) CSS(wc io.Writer) {
+	l.spacing.css(wc)
+}
+
+//==============================================================================
+
+// WordSpacing defines a sequence for animating the word-spacing of an element
+// towards the Value in its Unit, which defaults to px. A computed
+// word-spacing of normal is read as 0.
+type WordSpacing struct {
+	From   string       `govfx:"from"`
+	Value  float64      `govfx:"value"`
+	Unit   string       `govfx:"unit"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	spacing spacing
+}
+
+// Init initializes the word-spacing with the provided element for animation.
+func (w *WordSpacing) Init(elem govfx.Elemental) {
+	if w.Easer == nil {
+		w.Easer = govfx.GetEasing(w.Easing)
+	}
+
+	w.spacing.init(elem, "word-spacing", w.From, w.Value, w.Unit)
+}
+
+// Update contains the update operations for the word-spacing.
+func (w *WordSpacing) Update(delta float64, timeline float64) {
+	w.spacing.update(w.Easer.Ease(timeline))
+}
+
+// CSS writes the css output to the supplied writer.
+func (w *WordSpacing) CSS(wc io.Writer) {
+	w.spacing.css(wc)
+}
+
+//==============================================================================
+
+// spacing defines the state shared by the letter and word spacing sequences.
+type spacing struct {
+	prop    string
+	unit    string
+	start   float64
+	target  float64
+	current float64
+}
+
+// init reads the start of the spacing property in the unit of the target,
+// from the computed styles of the element unless from is set, converting px
+// and em values by the font-size of the element.
+func (s *spacing) init(elem govfx.Elemental, prop, from string, target float64, unit string) {
+	s.prop, s.target, s.start = prop, target, 0
+
+	if s.unit = strings.ToLower(unit); s.unit == "" {
+		s.unit = "px"
+	}
+
+	value := from
+	if value == "" {
+		value, _, _ = elem.Read(prop, "")
+	}
+
+	if mag, vunit, err := govfx.ParseUnit(value); err == nil && !strings.EqualFold(value, "normal") {
+		if vunit == "" {
+			vunit = "px"
+		}
+
+		fontSize, _, _ := elem.ReadFloat("font-size", "")
+
+		switch {
+		case vunit == s.unit:
+			s.start = mag
+		case vunit == "px" && s.unit == "em" && fontSize > 0:
+			s.start = mag / fontSize
+		case vunit == "em" && s.unit == "px":
+			s.start = mag * fontSize
+		}
+	}
+
+	s.current = s.start
+}
+
+// update moves the spacing to the eased position between its start and
+// target.
+func (s *spacing) update(ease float64) {
+	s.current = s.start + ((s.target - s.start) * ease)
+}
+
+// css writes the spacing property to the supplied writer.
+func (s *spacing) css(wc io.Writer) {
+	wc.Write([]byte(fmt.Sprintf("%s: %.2f%s;", s.prop, s.current, s.unit)))
+}
+
+//==============================================================================
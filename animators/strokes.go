@@ -0,0 +1,80 @@
+package animators
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/influx6/govfx"
+)
+
+//==============================================================================
+
+// StrokeDashoffset defines a sequence for animating the stroke-dashoffset of
+// a svg element towards Value, eg for line drawing animations. When the
+// element is a svg path without a stroke-dasharray, its dasharray is set to
+// the length of the path and the offset starts from that length, hence
+// animating to a Value of 0 draws the path from its start to its end.
+type StrokeDashoffset struct {
+	From   string       `govfx:"from"`
+	Value  float64      `govfx:"value"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	start   float64
+	current float64
+	length  float64
+}
+
+// Init initializes the offset with the provided element for animation.
+func (s *StrokeDashoffset) Init(elem govfx.Elemental) {
+	if s.Easer == nil {
+		s.Easer = govfx.GetEasing(s.Easing)
+	}
+
+	s.length = 0
+	s.start, _, _ = elem.ReadFloat("stroke-dashoffset", "")
+
+	if length, ok := pathLength(elem); ok {
+		if dash, _, ok := elem.Read("stroke-dasharray", ""); !ok || dash == "" || strings.EqualFold(dash, "none") {
+			s.length = length
+			s.start = length
+		}
+	}
+
+	if s.From != "" {
+		s.start = govfx.ParseFloat(s.From)
+	}
+
+	s.current = s.start
+}
+
+// Update contains the update operations for the offset.
+func (s *StrokeDashoffset) Update(delta float64, timeline float64) {
+	s.current = s.start + ((s.Value - s.start) * s.Easer.Ease(timeline))
+}
+
+// CSS writes the css output to the supplied writer.
+func (s *StrokeDashoffset) CSS(wc io.Writer) {
+	if s.length > 0 {
+		wc.Write([]byte(fmt.Sprintf("stroke-dasharray: %.2f;", s.length)))
+	}
+
+	wc.Write([]byte(fmt.Sprintf("stroke-dashoffset: %.2f;", s.current)))
+}
+
+//==============================================================================
+
+// pathLength returns the total length of the element if it is a svg
+// geometry, eg a path, which provides its length through getTotalLength.
+func pathLength(elem govfx.Elemental) (float64, bool) {
+	node := elem.Underlying()
+	if node == nil || node == js.Undefined || node.Get("getTotalLength") == js.Undefined {
+		return 0, false
+	}
+
+	return node.Call("getTotalLength").Float(), true
+}
+
+//==============================================================================
@@ -1,181 +1,233 @@
 package animators
 
+import (
+	"fmt"
+	"io"
+
+	"github.com/influx6/govfx"
+)
+
+//==============================================================================
+
+// TranslateX defines a sequence for animating css translate x-axes properties.
+// A Unit of % translates by a percentage of the width of the element, where
+// a start read in pixels, eg from the matrix of its computed transform, is
+// converted into a percentage of its width.
+type TranslateX struct {
+	From     string       `govfx:"from"`
+	Target   float64      `govfx:"value"`
+	Unit     string       `govfx:"unit"`
+	Relative string       `govfx:"relative"`
+	Easing   string       `govfx:"easing"`
+	Easer    govfx.Easing `govfx:"easer"`
+
+	start   float64
+	target  float64
+	current float64
+}
+
+// Init initializes the translation with the provided element for animation.
+func (t *TranslateX) Init(elem govfx.Elemental) {
+	if t.Easer == nil {
+		t.Easer = govfx.GetEasing(t.Easing)
+	}
+
+	t.Unit = govfx.Unit(t.Unit)
+	t.start = translation(t.From)
+	if t.From == "" {
+		t.start = readTranslation(elem, 0, t.Unit)
+	}
+	t.target = govfx.ResolveRelative(t.start, t.Target, t.Relative)
+	t.current = t.start
+}
+
+// Update contains the update operations for the translation.
+func (t *TranslateX) Update(delta float64, timeline float64) {
+	t.current = t.start + ((t.target - t.start) * t.Easer.Ease(timeline))
+}
+
+// CSS writes the css output to the supplied writer
+func (t *TranslateX) CSS(wc io.Writer) {
+	wc.Write([]byte(fmt.Sprintf("transform: translateX(%.2f%s);", t.current, t.Unit)))
+}
+
+//==============================================================================
+
+// TranslateY defines a sequence for animating css translate y-axes properties.
+// A Unit of % translates by a percentage of the height of the element, see
+// TranslateX.
+type TranslateY struct {
+	From     string       `govfx:"from"`
+	Target   float64      `govfx:"value"`
+	Unit     string       `govfx:"unit"`
+	Relative string       `govfx:"relative"`
+	Easing   string       `govfx:"easing"`
+	Easer    govfx.Easing `govfx:"easer"`
+
+	start   float64
+	target  float64
+	current float64
+}
+
+// Init initializes the translation with the provided element for animation.
+func (t *TranslateY) Init(elem govfx.Elemental) {
+	if t.Easer == nil {
+		t.Easer = govfx.GetEasing(t.Easing)
+	}
+
+	t.Unit = govfx.Unit(t.Unit)
+	t.start = translation(t.From)
+	if t.From == "" {
+		t.start = readTranslation(elem, 1, t.Unit)
+	}
+	t.target = govfx.ResolveRelative(t.start, t.Target, t.Relative)
+	t.current = t.start
+}
+
+// Update contains the update operations for the translation.
+func (t *TranslateY) Update(delta float64, timeline float64) {
+	t.current = t.start + ((t.target - t.start) * t.Easer.Ease(timeline))
+}
+
+// CSS writes the css output to the supplied writer
+func (t *TranslateY) CSS(wc io.Writer) {
+	wc.Write([]byte(fmt.Sprintf("transform: translateY(%.2f%s);", t.current, t.Unit)))
+}
+
 //==============================================================================
 
-// // TranslateY defines a sequence for animating css translate y-axes properties.
-// type TranslateY struct {
-// 	Value  int    `govfx:"value"`
-// 	Easing string `govfx:"easing"`
-// }
-//
-// // Init returns the initial writers for the sequence.
-// func (t TranslateY) Init(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "translate")
-// 		position, pr, _ := elem.Read("position", "")
-//
-// 		if utils.MatchAny(position, "none", "") {
-// 			position = "relative"
-// 		}
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var x, y float64
-//
-// 			if govfx.IsMatrix(transform) {
-// 				mx, _ := govfx.ToMatrix2D(transform)
-// 				x, y = mx.PositionX, mx.PositionY
-// 			} else if govfx.IsTranslation(transform) {
-// 				mx, _ := govfx.ToTranslation(transform)
-// 				x, y = mx.X, mx.Y
-// 			} else {
-// 				// x, y = govfx.Position(e)
-// 				x, y = 0, 0
-// 			}
-//
-// 			transform = fmt.Sprintf("translate(%.0fpx, %.0fpx)", x, y)
-//
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", transform, priority)
-// 				e.Write("position", position, pr)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// // Next returns the writers for the next sequence.
-// func (t TranslateY) Next(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	easing := govfx.GetEasing(t.Easing)
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "translate")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var x, y float64
-//
-// 			if govfx.IsMatrix(transform) {
-// 				mx, _ := govfx.ToMatrix2D(transform)
-// 				x, y = mx.PositionX, mx.PositionY
-// 			} else if govfx.IsTranslation(transform) {
-// 				mx, _ := govfx.ToTranslation(transform)
-// 				x, y = mx.X, mx.Y
-// 			}
-//
-// 			yd := float64(t.Value) - y
-//
-// 			yn := easing.Ease(govfx.EaseConfig{
-// 				Stat:         stats,
-// 				CurrentValue: y,
-// 				DeltaValue:   yd,
-// 			})
-//
-// 			transform = fmt.Sprintf("translate(%.0fpx, %.0fpx)", x, yn)
-// 			e.EraseMore("transform", "matrix", false)
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.WriteMore("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// //==============================================================================
-//
-// // TranslateX defines a sequence for animating css translate x-axes properties.
-// type TranslateX struct {
-// 	Value  int    `govfx:"value"`
-// 	Easing string `govfx:"easing"`
-// }
-//
-// // Init returns the initial writers for the sequence.
-// func (t TranslateX) Init(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "translate")
-// 		position, pr, _ := elem.Read("position", "")
-//
-// 		if utils.MatchAny(position, "none", "") {
-// 			position = "relative"
-// 		}
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var x, y float64
-//
-// 			if govfx.IsMatrix(transform) {
-// 				mx, _ := govfx.ToMatrix2D(transform)
-// 				x, y = mx.PositionX, mx.PositionX
-// 			} else if govfx.IsTranslation(transform) {
-// 				mx, _ := govfx.ToTranslation(transform)
-// 				x, y = mx.X, mx.X
-// 			} else {
-// 				// x, y = govfx.Position(e)
-// 				x, y = 0, 0
-// 			}
-//
-// 			transform = fmt.Sprintf("translate(%.0fpx, %.0fpx)", x, y)
-//
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", transform, priority)
-// 				e.Write("position", position, pr)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// // Next returns the writers for the next sequence.
-// func (t TranslateX) Next(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	easing := govfx.GetEasing(t.Easing)
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "translate")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var x, y float64
-//
-// 			if govfx.IsMatrix(transform) {
-// 				mx, _ := govfx.ToMatrix2D(transform)
-// 				x, y = mx.PositionX, mx.PositionX
-// 			} else if govfx.IsTranslation(transform) {
-// 				mx, _ := govfx.ToTranslation(transform)
-// 				x, y = mx.X, mx.X
-// 			}
-//
-// 			xd := float64(t.Value) - x
-//
-// 			xn := easing.Ease(govfx.EaseConfig{
-// 				Stat:         stats,
-// 				CurrentValue: x,
-// 				DeltaValue:   xd,
-// 			})
-//
-// 			transform = fmt.Sprintf("translate(%.0fpx, %.0fpx)", xn, y)
-// 			e.EraseMore("transform", "matrix", false)
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.WriteMore("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
+// TranslateZ defines a sequence for animating css translate z-axes properties.
+type TranslateZ struct {
+	From     string       `govfx:"from"`
+	Target   float64      `govfx:"value"`
+	Unit     string       `govfx:"unit"`
+	Relative string       `govfx:"relative"`
+	Easing   string       `govfx:"easing"`
+	Easer    govfx.Easing `govfx:"easer"`
+
+	start   float64
+	target  float64
+	current float64
+}
+
+// Init initializes the translation with the provided element for animation.
+func (t *TranslateZ) Init(elem govfx.Elemental) {
+	if t.Easer == nil {
+		t.Easer = govfx.GetEasing(t.Easing)
+	}
+
+	t.Unit = govfx.Unit(t.Unit)
+	t.start = translation(t.From)
+	if t.From == "" {
+		t.start = readTranslation(elem, 2, t.Unit)
+	}
+	t.target = govfx.ResolveRelative(t.start, t.Target, t.Relative)
+	t.current = t.start
+}
+
+// Update contains the update operations for the translation.
+func (t *TranslateZ) Update(delta float64, timeline float64) {
+	t.current = t.start + ((t.target - t.start) * t.Easer.Ease(timeline))
+}
+
+// CSS writes the css output to the supplied writer
+func (t *TranslateZ) CSS(wc io.Writer) {
+	wc.Write([]byte(fmt.Sprintf("transform: translateZ(%.2f%s);", t.current, t.Unit)))
+}
+
+//==============================================================================
+
+// translateFuncs defines the single axis translate functions for the x, y and
+// z axes.
+var translateFuncs = [3]string{"translateX", "translateY", "translateZ"}
+
+// readTranslation returns the current translation along the giving axis(0: x,
+// 1: y, 2: z) of the element's transform in the unit, reading either from its
+// translate functions or from its transformation matrix.
+func readTranslation(elem govfx.Elemental, axis int, unit string) float64 {
+	if val, _, ok := elem.Read("transform", translateFuncs[axis]); ok {
+		return translationIn(elem, axis, govfx.FunctionArgs(val)[0], unit)
+	}
+
+	for _, fn := range []string{"translate3d", "translate"} {
+		if val, _, ok := elem.Read("transform", fn); ok {
+			if args := govfx.FunctionArgs(val); len(args) > axis {
+				return translationIn(elem, axis, args[axis], unit)
+			}
+
+			return 0
+		}
+	}
+
+	// A matrix holds its translation in pixels.
+	for _, fn := range []string{"matrix3d", "matrix"} {
+		if val, _, ok := elem.Read("transform", fn); ok {
+			if mx, err := govfx.ToMatrix2D(val); err == nil {
+				return translationIn(elem, axis, govfx.FormatUnit([3]float64{mx.PositionX, mx.PositionY, mx.PositionZ}[axis], "px"), unit)
+			}
+		}
+	}
+
+	return 0
+}
+
+// translation returns the signed magnitude of the translate value, eg -10 for
+// -10px.
+func translation(value string) float64 {
+	mag, _, err := govfx.ParseUnit(value)
+	if err != nil {
+		return govfx.ParseFloat(value)
+	}
+
+	return mag
+}
+
+// translationIn returns the translation along the axis in the unit, where
+// pixels and percentages are converted between each other using the size of
+// the element along the axis, which a percentage translates by. Other units
+// are returned as their magnitude.
+func translationIn(elem govfx.Elemental, axis int, value string, unit string) float64 {
+	mag, from, err := govfx.ParseUnit(value)
+	if err != nil {
+		return govfx.ParseFloat(value)
+	}
+
+	if from == "" {
+		from = "px"
+	}
+
+	if from == unit || axis > 1 || (from != "%" && unit != "%") || (from != "px" && unit != "px") {
+		return mag
+	}
+
+	px := borderBox(elem, axis)
+	if px == 0 {
+		return mag
+	}
+
+	if unit == "%" {
+		return mag * 100 / px
+	}
+
+	return mag * px / 100
+}
+
+// borderBox returns the size of the element along the axis, being the
+// offsetWidth or offsetHeight of elements within the dom, whose border box
+// percentages translate by, else their computed width or height.
+func borderBox(elem govfx.Elemental, axis int) float64 {
+	if em, ok := elem.(*govfx.Element); ok && em.Element != nil {
+		return em.Underlying().Get([2]string{"offsetWidth", "offsetHeight"}[axis]).Float()
+	}
+
+	size, _, _ := elem.Read([2]string{"width", "height"}[axis], "")
+
+	px, _, err := govfx.ParseUnit(size)
+	if err != nil {
+		return 0
+	}
+
+	return px
+}
 
 //==============================================================================
@@ -0,0 +1,107 @@
+package animators
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/influx6/govfx"
+)
+
+//==============================================================================
+
+// Variable defines a sequence for animating a css custom property, eg
+// --accent or --gap, towards its Value. Colors are interpolated as colors,
+// while numeric values are interpolated in the Unit provided, else in the
+// unit of the Value or current value. Animating a variable on a shared
+// ancestor, eg the root element, cascades the animation to all elements
+// using it. A relative Value, eg +=4px, is applied to the current value.
+type Variable struct {
+	Name   string       `govfx:"name"`
+	From   string       `govfx:"from"`
+	Value  string       `govfx:"value"`
+	Unit   string       `govfx:"unit"`
+	HSL    bool         `govfx:"hsl"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	name    string
+	start   string
+	target  string
+	current string
+}
+
+// Init initializes the variable with the provided element for animation.
+func (v *Variable) Init(elem govfx.Elemental) {
+	if v.Easer == nil {
+		v.Easer = govfx.GetEasing(v.Easing)
+	}
+
+	v.name = v.Name
+	if !govfx.IsCustomProperty(v.name) {
+		v.name = "--" + strings.TrimSpace(v.name)
+	}
+
+	v.start = strings.TrimSpace(v.From)
+	if v.start == "" {
+		val, _, _ := elem.Read(v.name, "")
+		v.start = strings.TrimSpace(val)
+	}
+
+	// An unset variable starts from the zero of the kind of its target.
+	if v.start == "" {
+		if govfx.IsColor(v.Value) {
+			v.start = "transparent"
+		} else {
+			v.start = "0" + v.Unit
+		}
+	}
+
+	v.target = govfx.RelativeValue(v.start, v.Value)
+	v.current = v.start
+}
+
+// Transitionable returns false, as custom properties can not be transitioned
+// unless registered with the browser.
+func (v *Variable) Transitionable() bool {
+	return false
+}
+
+// Update contains the update operations for the variable.
+func (v *Variable) Update(delta float64, timeline float64) {
+	ease := v.Easer.Ease(timeline)
+
+	if govfx.IsColor(v.start) && govfx.IsColor(v.target) {
+		v.current = govfx.LerpColor(v.start, v.target, ease, colorMode(v.HSL))
+		return
+	}
+
+	fm, funit, ferr := govfx.ParseUnit(v.start)
+	tm, tunit, terr := govfx.ParseUnit(v.target)
+
+	if ferr == nil && terr == nil {
+		unit := v.Unit
+		if unit == "" {
+			unit = tunit
+		}
+
+		if unit == "" {
+			unit = funit
+		}
+
+		v.current = fmt.Sprintf("%.2f%s", fm+((tm-fm)*ease), unit)
+		return
+	}
+
+	v.current = v.start
+	if ease >= 0.5 {
+		v.current = v.target
+	}
+}
+
+// CSS writes the css output to the supplied writer.
+func (v *Variable) CSS(wc io.Writer) {
+	wc.Write([]byte(fmt.Sprintf("%s: %s;", v.name, v.current)))
+}
+
+//==============================================================================
@@ -0,0 +1,265 @@
+package govfx
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/influx6/faux/loop"
+)
+
+//==============================================================================
+
+// Batch starts several unrelated timelines together as a single Animation,
+// lighter than a Storyboard as each timeline keeps running off its own timer.
+// Begin is emitted once all timelines have begun, including those enqueued
+// behind conflicts, and End once all of them end, which does not happen when
+// any of them is stopped or loops forever. Progress receives the smallest
+// position(0..1) within the iterations of the timelines on every frame from
+// the begin, hence a combined progress bar follows the slowest of them.
+type Batch struct {
+	Begin    Listener
+	End      Listener
+	Progress Listener
+
+	timelines []*Timeline
+
+	ml      sync.Mutex
+	looper  loop.Looper
+	running int64
+	begun   int64
+}
+
+// AnimateAll returns a new Batch of the timelines, which are started
+// together by the Start method of the batch.
+func AnimateAll(timelines ...*Timeline) *Batch {
+	return &Batch{timelines: timelines}
+}
+
+// Start starts all timelines of the batch. Returns the error of the first
+// timeline with an invalid configuration without starting any of them, or
+// ErrConflict once a timeline ignoring conflicts is rejected, stopping the
+// timelines started before it.
+func (b *Batch) Start() error {
+	if !atomic.CompareAndSwapInt64(&b.running, 0, 1) {
+		return nil
+	}
+
+	for _, t := range b.timelines {
+		if t.err != nil {
+			atomic.StoreInt64(&b.running, 0)
+			return t.err
+		}
+	}
+
+	for index, t := range b.timelines {
+		if err := t.Start(); err != nil {
+			for _, started := range b.timelines[:index] {
+				started.Stop()
+			}
+
+			atomic.StoreInt64(&b.running, 0)
+			return err
+		}
+	}
+
+	atomic.StoreInt64(&b.begun, 0)
+
+	b.ml.Lock()
+	b.looper = scheduler.Loop(func(delta float64) {
+		b.tick()
+	})
+	b.ml.Unlock()
+
+	return nil
+}
+
+// Elements returns the animations of the individual elements animated by the
+// timelines of the batch.
+func (b *Batch) Elements() []*ElementAnimation {
+	var elems []*ElementAnimation
+
+	for _, t := range b.timelines {
+		elems = append(elems, t.Elements()...)
+	}
+
+	return elems
+}
+
+// Properties returns the properties animated for each element of the
+// timelines of the batch.
+func (b *Batch) Properties() map[Elemental][]string {
+	props := make(map[Elemental][]string)
+
+	for _, t := range b.timelines {
+		for elem, names := range t.Properties() {
+			props[elem] = append(props[elem], names...)
+		}
+	}
+
+	return props
+}
+
+// Frame returns a snapshot of the current state of the slowest timeline of
+// the batch, being the timeline at the smallest position.
+func (b *Batch) Frame() Frame {
+	slowest := b.slowest()
+	if slowest == nil {
+		return Frame{total: 1}
+	}
+
+	return slowest.Frame()
+}
+
+// State returns the current state of the batch, which is Running while any of
+// its timelines runs, else Paused while any of them is paused. A batch whose
+// timelines have all ended is Completed, unless any of them was stopped,
+// where it is Cancelled.
+func (b *Batch) State() AnimationState {
+	var paused, idle, cancelled bool
+
+	for _, t := range b.timelines {
+		switch t.State() {
+		case Running:
+			return Running
+		case Paused:
+			paused = true
+		case Idle:
+			idle = true
+		case Cancelled:
+			cancelled = true
+		}
+	}
+
+	switch {
+	case paused:
+		return Paused
+	case idle || len(b.timelines) == 0:
+		return Idle
+	case cancelled:
+		return Cancelled
+	}
+
+	return Completed
+}
+
+// Pause pauses all timelines of the batch.
+func (b *Batch) Pause() {
+	for _, t := range b.timelines {
+		t.Pause()
+	}
+}
+
+// Resume resumes all timelines of the batch.
+func (b *Batch) Resume() {
+	for _, t := range b.timelines {
+		t.Resume()
+	}
+}
+
+// Stop stops all timelines of the batch.
+func (b *Batch) Stop() {
+	b.halt()
+
+	for _, t := range b.timelines {
+		t.Stop()
+	}
+}
+
+// halt ends the loop of the batch, returning false if it was not running.
+func (b *Batch) halt() bool {
+	if !atomic.CompareAndSwapInt64(&b.running, 1, 0) {
+		return false
+	}
+
+	b.ml.Lock()
+	defer b.ml.Unlock()
+
+	if b.looper != nil {
+		b.looper.End()
+	}
+
+	return true
+}
+
+// tick emits the begin of the batch once all of its timelines have begun,
+// its progress, then its end once all of its timelines ended.
+func (b *Batch) tick() {
+	if atomic.LoadInt64(&b.running) < 1 {
+		return
+	}
+
+	if atomic.LoadInt64(&b.begun) < 1 {
+		for _, t := range b.timelines {
+			if atomic.LoadInt64(&t.begun) > 0 {
+				continue
+			}
+
+			// A timeline stopped before its begin ends the batch below.
+			if !t.ended() {
+				return
+			}
+		}
+
+		if atomic.CompareAndSwapInt64(&b.begun, 0, 1) && b.Begin != nil && b.State() != Cancelled {
+			b.Begin.Emit(0)
+		}
+	}
+
+	if b.Progress != nil {
+		b.Progress.Emit(b.position())
+	}
+
+	for _, t := range b.timelines {
+		if !t.ended() {
+			return
+		}
+	}
+
+	// A stopped timeline ends the batch without its end.
+	for _, t := range b.timelines {
+		if t.State() == Cancelled {
+			b.halt()
+			return
+		}
+	}
+
+	if b.halt() && b.End != nil {
+		b.End.Emit(1)
+	}
+}
+
+// position returns the smallest position(0..1) within the iterations of the
+// timelines, where ended timelines are at 1.
+func (b *Batch) position() float64 {
+	if slowest := b.slowest(); slowest != nil {
+		return batchPosition(slowest)
+	}
+
+	return 1
+}
+
+// slowest returns the timeline of the batch at the smallest position, which
+// is nil for a batch without timelines.
+func (b *Batch) slowest() *Timeline {
+	var slowest *Timeline
+
+	for _, t := range b.timelines {
+		if slowest == nil || batchPosition(t) < batchPosition(slowest) {
+			slowest = t
+		}
+	}
+
+	return slowest
+}
+
+// batchPosition returns the position(0..1) of the timeline within its
+// iteration, being 1 once it ended.
+func batchPosition(t *Timeline) float64 {
+	if t.ended() {
+		return 1
+	}
+
+	return t.position()
+}
+
+//==============================================================================
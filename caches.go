@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	"github.com/influx6/faux/loop"
+	"honnef.co/go/js/dom"
 )
 
 //==============================================================================
@@ -58,3 +59,65 @@ func (s *loopCache) Delete(f Timer) {
 }
 
 //==============================================================================
+
+// styleCache contains the computed styles of the elements read within the
+// current frame of the animation loop.
+var styleCache = newComputedStyleCache()
+
+// computedStyleKey defines the key of the computed styles of a element.
+type computedStyleKey struct {
+	elem   dom.Element
+	pseudo string
+}
+
+// computedStyleCache defines a struct for storing the computed styles of
+// elements for the duration of a frame. Styles are only stored while a frame
+// is running.
+type computedStyleCache struct {
+	rl     sync.RWMutex
+	active bool
+	c      map[computedStyleKey]*dom.CSSStyleDeclaration
+}
+
+// newComputedStyleCache returns a new instance of a computedStyleCache.
+func newComputedStyleCache() *computedStyleCache {
+	cs := computedStyleCache{c: make(map[computedStyleKey]*dom.CSSStyleDeclaration)}
+	return &cs
+}
+
+// Begin starts storing computed styles for a new frame, dropping the styles
+// of the previous frame.
+func (s *computedStyleCache) Begin() {
+	s.rl.Lock()
+	defer s.rl.Unlock()
+	s.active = true
+	s.c = make(map[computedStyleKey]*dom.CSSStyleDeclaration)
+}
+
+// End stops storing computed styles, dropping the styles of the frame.
+func (s *computedStyleCache) End() {
+	s.rl.Lock()
+	defer s.rl.Unlock()
+	s.active = false
+	s.c = make(map[computedStyleKey]*dom.CSSStyleDeclaration)
+}
+
+// Get returns the computed styles of the element stored within the frame.
+func (s *computedStyleCache) Get(elem dom.Element, pseudo string) (*dom.CSSStyleDeclaration, bool) {
+	s.rl.RLock()
+	defer s.rl.RUnlock()
+	css, ok := s.c[computedStyleKey{elem: elem, pseudo: pseudo}]
+	return css, ok
+}
+
+// Add stores the computed styles of the element if a frame is running.
+func (s *computedStyleCache) Add(elem dom.Element, pseudo string, css *dom.CSSStyleDeclaration) {
+	s.rl.Lock()
+	defer s.rl.Unlock()
+
+	if s.active {
+		s.c[computedStyleKey{elem: elem, pseudo: pseudo}] = css
+	}
+}
+
+//==============================================================================
@@ -0,0 +1,208 @@
+package govfx
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+//==============================================================================
+
+// ErrInvalidCalc is returned when a calc expression is malformed.
+var ErrInvalidCalc = errors.New("Invalid calc expression")
+
+// ErrCalcUnits is returned when a calc expression holds units which can not
+// be reconciled, eg em units without a font-size to resolve them against.
+var ErrCalcUnits = errors.New("Unreconcilable calc units")
+
+// EvalCalc evaluates a css calc expression, eg calc(100% - 20px), into pixels,
+// resolving percentages against the basis, eg the width of the parent box of
+// the element. The expression may use +, -, * and / with their usual
+// precedence and nest parentheses or further calc calls. Lengths may only be
+// added to or subtracted from lengths, multiplied by numbers and divided by
+// numbers. Returns ErrCalcUnits for units other than px and %, else
+// ErrInvalidCalc for malformed expressions.
+func EvalCalc(expr string, basis float64) (float64, error) {
+	p := calcParser{src: strings.TrimSpace(expr), basis: basis}
+
+	val, err := p.sum()
+	if err != nil {
+		return 0, err
+	}
+
+	if p.skipSpaces(); p.pos < len(p.src) {
+		return 0, ErrInvalidCalc
+	}
+
+	return val.num, nil
+}
+
+// calcValue defines a value within a calc expression, being a length in
+// pixels or a plain number.
+type calcValue struct {
+	num    float64
+	length bool
+}
+
+// calcParser defines a recursive descent parser evaluating calc expressions.
+type calcParser struct {
+	src   string
+	pos   int
+	basis float64
+}
+
+// sum evaluates the additions and subtractions of the expression at the
+// current position.
+func (p *calcParser) sum() (calcValue, error) {
+	left, err := p.product()
+	if err != nil {
+		return left, err
+	}
+
+	for {
+		p.skipSpaces()
+
+		if p.pos >= len(p.src) || (p.src[p.pos] != '+' && p.src[p.pos] != '-') {
+			return left, nil
+		}
+
+		op := p.src[p.pos]
+		p.pos++
+
+		right, err := p.product()
+		if err != nil {
+			return left, err
+		}
+
+		if left.length != right.length {
+			return left, ErrCalcUnits
+		}
+
+		if op == '+' {
+			left.num += right.num
+		} else {
+			left.num -= right.num
+		}
+	}
+}
+
+// product evaluates the multiplications and divisions of the expression at
+// the current position.
+func (p *calcParser) product() (calcValue, error) {
+	left, err := p.operand()
+	if err != nil {
+		return left, err
+	}
+
+	for {
+		p.skipSpaces()
+
+		if p.pos >= len(p.src) || (p.src[p.pos] != '*' && p.src[p.pos] != '/') {
+			return left, nil
+		}
+
+		op := p.src[p.pos]
+		p.pos++
+
+		right, err := p.operand()
+		if err != nil {
+			return left, err
+		}
+
+		switch {
+		case op == '*' && left.length && right.length:
+			return left, ErrCalcUnits
+		case op == '*':
+			left = calcValue{num: left.num * right.num, length: left.length || right.length}
+		case right.length:
+			return left, ErrCalcUnits
+		case right.num == 0:
+			return left, ErrInvalidCalc
+		default:
+			left.num /= right.num
+		}
+	}
+}
+
+// operand evaluates the number, length or parenthesized expression at the
+// current position.
+func (p *calcParser) operand() (calcValue, error) {
+	p.skipSpaces()
+
+	if p.pos >= len(p.src) {
+		return calcValue{}, ErrInvalidCalc
+	}
+
+	switch {
+	case p.src[p.pos] == '-' || p.src[p.pos] == '+':
+		neg := p.src[p.pos] == '-'
+		p.pos++
+
+		val, err := p.operand()
+		if neg {
+			val.num = -val.num
+		}
+
+		return val, err
+	case strings.HasPrefix(strings.ToLower(p.src[p.pos:]), "calc("):
+		p.pos += len("calc")
+		return p.operand()
+	case p.src[p.pos] == '(':
+		p.pos++
+
+		val, err := p.sum()
+		if err != nil {
+			return val, err
+		}
+
+		if p.skipSpaces(); p.pos >= len(p.src) || p.src[p.pos] != ')' {
+			return val, ErrInvalidCalc
+		}
+
+		p.pos++
+		return val, nil
+	}
+
+	return p.unitValue()
+}
+
+// unitValue evaluates the number or length at the current position.
+func (p *calcParser) unitValue() (calcValue, error) {
+	start := p.pos
+
+	for p.pos < len(p.src) && (p.src[p.pos] == '.' || unicode.IsDigit(rune(p.src[p.pos]))) {
+		p.pos++
+	}
+
+	num, err := strconv.ParseFloat(p.src[start:p.pos], 64)
+	if err != nil {
+		return calcValue{}, ErrInvalidCalc
+	}
+
+	unitStart := p.pos
+
+	for p.pos < len(p.src) && (p.src[p.pos] == '%' || unicode.IsLetter(rune(p.src[p.pos]))) {
+		p.pos++
+	}
+
+	switch strings.ToLower(p.src[unitStart:p.pos]) {
+	case "":
+		return calcValue{num: num}, nil
+	case "px":
+		return calcValue{num: num, length: true}, nil
+	case "%":
+		return calcValue{num: p.basis * num / 100, length: true}, nil
+	}
+
+	return calcValue{}, ErrCalcUnits
+}
+
+// skipSpaces moves the position past any whitespace.
+func (p *calcParser) skipSpaces() {
+	for p.pos < len(p.src) && unicode.IsSpace(rune(p.src[p.pos])) {
+		p.pos++
+	}
+}
+
+//==============================================================================
@@ -0,0 +1,61 @@
+package govfx
+
+import (
+	"sync"
+	"time"
+)
+
+//==============================================================================
+
+// Clock defines the source of time for the timers of timelines, reporting the
+// time elapsed since a fixed point of its own choosing. Only the differences
+// between the times reported matter, hence a test may start its clock at zero
+// and advance it by fixed steps.
+type Clock interface {
+	Now() time.Duration
+}
+
+// realClock defines the default Clock, reading the system time.
+type realClock struct {
+	epoch time.Time
+}
+
+// Now returns the time elapsed since the clock was created.
+func (r realClock) Now() time.Duration {
+	return time.Since(r.epoch)
+}
+
+// clockEpoch is the point the times of the clock are added onto.
+var clockEpoch = time.Now()
+
+var clockMl sync.RWMutex
+var clock Clock = realClock{epoch: clockEpoch}
+
+// SetClock sets the clock read by the timers of timelines, where a nil clock
+// restores the system time. Timelines running while the clock is changed see
+// their time jump by the difference between the clocks.
+func SetClock(c Clock) {
+	if c == nil {
+		c = realClock{epoch: clockEpoch}
+	}
+
+	clockMl.Lock()
+	defer clockMl.Unlock()
+
+	clock = c
+}
+
+// clockNow returns the current time of the clock.
+func clockNow() time.Time {
+	clockMl.RLock()
+	defer clockMl.RUnlock()
+
+	return clockEpoch.Add(clock.Now())
+}
+
+// clockSince returns the time of the clock elapsed since the giving time.
+func clockSince(t time.Time) time.Duration {
+	return clockNow().Sub(t)
+}
+
+//==============================================================================
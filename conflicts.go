@@ -0,0 +1,144 @@
+package govfx
+
+import (
+	"errors"
+	"sync"
+)
+
+//==============================================================================
+
+// ErrConflict is returned when starting a timeline whose stat ignores
+// conflicts while another timeline animates the same properties of its
+// elements.
+var ErrConflict = errors.New("Conflicting animation running")
+
+// ConflictPolicy defines how a timeline starts while other timelines animate
+// the same properties of the same elements.
+type ConflictPolicy int
+
+// contains the policies for conflicting timelines.
+const (
+	// Replace stops the running timelines, which emit their cancel signal.
+	Replace ConflictPolicy = iota
+
+	// Ignore leaves the running timelines be, rejecting the new timeline.
+	Ignore
+
+	// Enqueue starts the new timeline once the running timelines end.
+	Enqueue
+)
+
+//==============================================================================
+
+// claims tracks the properties animated by the running timelines.
+var claims propertyClaims
+
+// propertyClaim defines the properties of an element animated by a timeline.
+type propertyClaim struct {
+	elem     interface{}
+	props    []string
+	timeline *Timeline
+}
+
+// propertyClaims defines the set of properties of elements claimed by running
+// timelines, allowing timelines animating the same property of the same
+// element to be resolved by their ConflictPolicy.
+type propertyClaims struct {
+	ml     sync.Mutex
+	claims []propertyClaim
+}
+
+// Claimable defines a interface for TimelineBehaviours which report the
+// properties they animate for each of their elements.
+type Claimable interface {
+	Claims() map[Elemental][]string
+}
+
+// Claim returns the running timelines conflicting with the timeline, claiming
+// the properties of the timeline unless its policy is to ignore or enqueue
+// behind the conflicting timelines.
+func (c *propertyClaims) Claim(t *Timeline) []*Timeline {
+	cl, ok := t.tb.(Claimable)
+	if !ok {
+		return nil
+	}
+
+	owned := cl.Claims()
+
+	c.ml.Lock()
+	defer c.ml.Unlock()
+
+	var conflicts []*Timeline
+	var alive []propertyClaim
+
+	for _, claim := range c.claims {
+		if claim.timeline.ended() {
+			continue
+		}
+
+		alive = append(alive, claim)
+
+		if claim.timeline == t || containsTimeline(conflicts, claim.timeline) {
+			continue
+		}
+
+		for elem, props := range owned {
+			if claim.elem == claimKey(elem) && overlaps(claim.props, props) {
+				conflicts = append(conflicts, claim.timeline)
+				break
+			}
+		}
+	}
+
+	c.claims = alive
+
+	if len(conflicts) > 0 && t.stat.OnConflict != Replace {
+		return conflicts
+	}
+
+	for elem, props := range owned {
+		c.claims = append(c.claims, propertyClaim{
+			elem:     claimKey(elem),
+			props:    props,
+			timeline: t,
+		})
+	}
+
+	return conflicts
+}
+
+// claimKey returns the key identifying the dom element of the elemental,
+// hence different elementals of the same dom element share their claims.
+func claimKey(elem Elemental) interface{} {
+	if em, ok := elem.(*Element); ok && em.Element != nil {
+		return em.Underlying()
+	}
+
+	return elem
+}
+
+// overlaps returns true/false if any property is within both lists.
+func overlaps(a, b []string) bool {
+	for _, ap := range a {
+		for _, bp := range b {
+			if ap == bp {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// containsTimeline returns true/false if the timeline is within the list.
+func containsTimeline(timelines []*Timeline, t *Timeline) bool {
+	for _, item := range timelines {
+		if item == t {
+			return true
+		}
+	}
+
+	return false
+}
+
+//==============================================================================
@@ -3,8 +3,13 @@ package govfx
 import (
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/gopherjs/gopherjs/js"
 	"honnef.co/go/js/dom"
@@ -12,28 +17,72 @@ import (
 
 //==============================================================================
 
-// GetComputedStyle returns the dom.Element computed css styles.
+// GetComputedStyle returns the dom.Element computed css styles, using the
+// window of the document owning the element. Within a frame of the animation
+// loop the styles are retrieved once per element and pseudo element, hence
+// multiple reads of the same element reuse them.
 func GetComputedStyle(elem dom.Element, ps string) (*dom.CSSStyleDeclaration, error) {
-	css := Window().GetComputedStyle(elem, ps)
+	if css, ok := styleCache.Get(elem, ps); ok {
+		return css, nil
+	}
+
+	css := windowOf(elem).GetComputedStyle(elem, ps)
 	if css == nil {
 		return nil, ErrNotFound
 	}
 
+	styleCache.Add(elem, ps, css)
 	return css, nil
 }
 
 // RemoveComputedStyleValue removes the value of the property from the computed
-// style object.
-func RemoveComputedStyleValue(css *dom.CSSStyleDeclaration, prop string) {
+// style object. Returns ErrNotFound if the style object held no value for the
+// property, eg when its vendored name differs from the name it was set with,
+// or the exception thrown by the browser wrapped within the error.
+func RemoveComputedStyleValue(css *dom.CSSStyleDeclaration, prop string) (err error) {
+	if css == nil || css.Object == nil {
+		return ErrNotFound
+	}
+
 	defer func() {
-		recover()
+		if rec := recover(); rec != nil {
+			jsErr, ok := rec.(*js.Error)
+			if !ok {
+				panic(rec)
+			}
+
+			err = fmt.Errorf("Failed to remove %s: %v", prop, jsErr)
+		}
 	}()
 
-	css.Call("removeProperty", prop)
+	if removed := css.Call("removeProperty", prop); removed == nil || removed.String() == "" {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// RemoveComputedStyleValues removes the values of the properties from the
+// style object, eg the inline styles left by an animation, returning the
+// properties which failed to be removed or held no value. Each property is
+// removed on its own, hence a failing property does not keep the others from
+// being removed.
+func RemoveComputedStyleValues(css *dom.CSSStyleDeclaration, props ...string) []string {
+	var failed []string
+
+	for _, prop := range props {
+		if err := RemoveComputedStyleValue(css, prop); err != nil {
+			failed = append(failed, prop)
+		}
+	}
+
+	return failed
 }
 
 // GetComputedStyleValue retrieves the value of the property from the computed
-// style object.
+// style object. Custom properties like --accent are supported, as browsers
+// resolve them through getPropertyValue while leaving them out of the listed
+// computed styles.
 func GetComputedStyleValue(elem dom.Element, psudo string, prop string) (*js.Object, error) {
 	vs, err := GetComputedStyle(elem, psudo)
 	if err != nil {
@@ -60,6 +109,12 @@ func GetComputedStyleValueWith(css *dom.CSSStyleDeclaration, prop string) (*js.O
 	return vs, nil
 }
 
+// IsCustomProperty returns true/false if the property is a css custom
+// property, eg --accent.
+func IsCustomProperty(prop string) bool {
+	return strings.HasPrefix(strings.TrimSpace(prop), "--")
+}
+
 // GetComputedStylePriority retrieves the proritiy of the property from the computed
 // style object.
 func GetComputedStylePriority(css *dom.CSSStyleDeclaration, prop string) (int, error) {
@@ -79,20 +134,161 @@ func GetComputedStylePriority(css *dom.CSSStyleDeclaration, prop string) (int, e
 
 // ComputedStyle defines a style property item.
 type ComputedStyle struct {
-	Name       string
-	VendorName string
-	Value      string
-	Values     []string
-	Priority   bool // values between [0,1] to indicate use of '!important'
+	Name        string
+	VendorName  string
+	VendorNames []string // names of all the forms merged into the property
+	Value       string
+	Values      []string
+	Priority    bool // values between [0,1] to indicate use of '!important'
+}
+
+// ErrNotScalar is returned when reading a number from a computed style
+// holding multiple values.
+var ErrNotScalar = errors.New("Not a scalar value")
+
+// Float returns the numeric magnitude and unit of the value of the style, eg
+// 42px returns 42 and px. Returns ErrNotScalar if the style holds multiple
+// values, eg a transform of multiple functions, else ErrInvalidUnit if its
+// value is not a unit value.
+func (cs *ComputedStyle) Float() (float64, string, error) {
+	value := cs.Value
+
+	switch len(cs.Values) {
+	case 0:
+	case 1:
+		value = cs.Values[0]
+	default:
+		return 0, "", ErrNotScalar
+	}
+
+	if len(SplitTopLevel(value, ' ')) > 1 {
+		return 0, "", ErrNotScalar
+	}
+
+	return ParseUnit(value)
+}
+
+// Int returns the numeric magnitude of the value of the style rounded to the
+// nearest integer, alongside its unit. See Float.
+func (cs *ComputedStyle) Int() (int, string, error) {
+	magnitude, unit, err := cs.Float()
+	if err != nil {
+		return 0, "", err
+	}
+
+	return int(math.Floor(magnitude + 0.5)), unit, nil
+}
+
+// ErrNotColor is returned when reading a color from a computed style which
+// does not hold a color.
+var ErrNotColor = errors.New("Not a color value")
+
+// Color returns the red, green, blue and alpha components(0..255) of the
+// color held by the style, which may be in its rgb(a), hex, named or hsl(a)
+// form, where transparent returns all components as 0. Returns ErrNotColor
+// if the style does not hold a color.
+func (cs *ComputedStyle) Color() (r, g, b, a int, err error) {
+	value := strings.TrimSpace(cs.Value)
+
+	if !IsColor(value) {
+		return 0, 0, 0, 0, ErrNotColor
+	}
+
+	red, green, blue, alpha := colorChannels(value)
+	return red, green, blue, int(math.Floor((alpha * 255) + 0.5)), nil
 }
 
 // ComputedStyleMap defines a map type of computed style properties and values.
 type ComputedStyleMap map[string]*ComputedStyle
 
-// GetComputedStyleMap returns a map of computed style properties and values.
-// Also all vendored names are cleaned up to allow quick and easy access
-// regardless of vendor.
+// StyleProvider defines a provider of the computed styles of elements, which
+// GetComputedStyleMap consults. The default provider reads the styles from
+// the window of the element, where a fake provider allows sequences to be
+// tested with canned styles without a browser.
+type StyleProvider interface {
+	ComputedStyle(elem dom.Element, pseudo string) (ComputedStyleMap, error)
+}
+
+// styleProvider contains the provider consulted for computed styles.
+var styleProvider StyleProvider = windowStyles{}
+
+// SetStyleProvider sets the provider consulted for the computed styles of
+// elements. Passing nil restores the default provider reading the styles
+// from the window.
+func SetStyleProvider(provider StyleProvider) {
+	if provider == nil {
+		provider = windowStyles{}
+	}
+
+	styleProvider = provider
+}
+
+// windowStyles defines the StyleProvider reading computed styles from the
+// window of the elements.
+type windowStyles struct{}
+
+// ComputedStyle returns the computed styles of the element from its window.
+func (windowStyles) ComputedStyle(elem dom.Element, ps string) (ComputedStyleMap, error) {
+	return windowStyleMap(elem, ps)
+}
+
+// GetComputedStyleMap returns a map of computed style properties and values
+// from the StyleProvider. Also all vendored names are cleaned up to allow
+// quick and easy access regardless of vendor.
 func GetComputedStyleMap(elem dom.Element, ps string) (ComputedStyleMap, error) {
+	return styleProvider.ComputedStyle(elem, ps)
+}
+
+// GetComputedStyleMapFor returns a map of only the named computed style
+// properties and values of the element, reading each property through
+// getPropertyValue rather than enumerating all computed styles, which spares
+// the cost of the hundreds of properties not needed. Properties without a
+// value are left out of the map. Fake providers set with SetStyleProvider
+// have their styles filtered to the named properties.
+func GetComputedStyleMapFor(elem dom.Element, ps string, props ...string) (ComputedStyleMap, error) {
+	styleMap := make(ComputedStyleMap, len(props))
+
+	if _, ok := styleProvider.(windowStyles); !ok {
+		all, err := styleProvider.ComputedStyle(elem, ps)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, prop := range props {
+			if cs, err := all.Get(prop); err == nil {
+				styleMap[prop] = cs
+			}
+		}
+
+		return styleMap, nil
+	}
+
+	css, err := GetComputedStyle(elem, ps)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, prop := range props {
+		vs, err := GetComputedStyleValueWith(css, prop)
+		if err != nil {
+			continue
+		}
+
+		value := strings.TrimSpace(vs.String())
+		if value == "" {
+			continue
+		}
+
+		priority, _ := GetComputedStylePriority(css, prop)
+		styleMap.Add(prop, value, priority > 0)
+	}
+
+	return styleMap, nil
+}
+
+// windowStyleMap returns a map of the computed style properties and values of
+// the element read from its window.
+func windowStyleMap(elem dom.Element, ps string) (ComputedStyleMap, error) {
 	css, err := GetComputedStyle(elem, ps)
 	if err != nil {
 		return nil, err
@@ -103,31 +299,49 @@ func GetComputedStyleMap(elem dom.Element, ps string) (ComputedStyleMap, error)
 	// Get the map and pull the necessary property:value and importance facts.
 	for key, val := range css.ToMap() {
 		priority, _ := GetComputedStylePriority(css, key)
+		styleMap.AddVendored(key, val, priority > 0)
+	}
 
-		unvendoredName := key
+	return styleMap, nil
+}
 
-		// Clean key of any vendored name to allow easy access.
-		for _, vo := range vendorTags {
-			unvendoredName = strings.TrimPrefix(unvendoredName, fmt.Sprintf("%s", vo))
-			unvendoredName = strings.TrimPrefix(unvendoredName, fmt.Sprintf("-%s-", vo))
-		}
+// AddVendored adds the value of the possibly vendor prefixed property under
+// its unvendored name, eg -webkit-transform is added as transform. When both
+// the unprefixed and prefixed forms of a property are added, the value of the
+// unprefixed form is kept, else the value of the first prefixed form by name,
+// regardless of the order they are added in. The names of all forms are kept
+// within the VendorNames of the property.
+func (c ComputedStyleMap) AddVendored(vendorName string, value string, priority bool) {
+	name := Unvendor(vendorName)
 
-		var vals []string
+	cs, ok := c[name]
+	if !ok {
+		c.Add(name, value, priority)
+		cs = c[name]
+		cs.VendorName = vendorName
+		cs.VendorNames = []string{vendorName}
+		return
+	}
 
-		if strings.TrimSpace(val) != "none" {
-			vals = append(vals, val)
+	for _, known := range cs.VendorNames {
+		if known == vendorName {
+			return
 		}
+	}
 
-		styleMap[unvendoredName] = &ComputedStyle{
-			Name:       unvendoredName,
-			VendorName: key,
-			Value:      val,
-			Values:     vals,
-			Priority:   (priority > 0),
-		}
+	cs.VendorNames = append(cs.VendorNames, vendorName)
+	sort.Strings(cs.VendorNames)
+
+	// The unprefixed form wins, else the first prefixed form by name.
+	if cs.VendorName == name || (vendorName != name && vendorName > cs.VendorName) {
+		return
 	}
 
-	return styleMap, nil
+	names := cs.VendorNames
+
+	c.Add(name, value, priority)
+	c[name].VendorName = vendorName
+	c[name].VendorNames = names
 }
 
 // Has returns true/false if the property exists.
@@ -136,25 +350,299 @@ func (c ComputedStyleMap) Has(name string) bool {
 	return ok
 }
 
-// Get retrieves the specific property if it exists.
+// Get retrieves the specific property if it exists. A missing longhand of a
+// box shorthand property, eg margin-top, is expanded from its shorthand when
+// the map holds it.
 func (c ComputedStyleMap) Get(name string) (*ComputedStyle, error) {
 	cs, ok := c[name]
 
+	if ok {
+		return cs, nil
+	}
+
+	if shorthand, ok := c[longhandShorthands[name]]; ok {
+		if value, ok := ExpandShorthand(shorthand.Name, shorthand.Value)[name]; ok {
+			return &ComputedStyle{
+				Name:     name,
+				Value:    value,
+				Values:   []string{value},
+				Priority: shorthand.Priority,
+			}, nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+// functionListProperties defines the properties whose values are a list of
+// css functions (eg translateX(10px) rotate(45deg)) which can be merged.
+var functionListProperties = map[string]bool{
+	"transform":       true,
+	"filter":          true,
+	"backdrop-filter": true,
+}
+
+// functionMatch defines a regexp for matching css functions within a value.
+var functionMatch = regexp.MustCompile("[\\w-]+\\([^\\)]*\\)")
+
+// SplitValues splits a css function list (eg translateX(10px) rotate(45deg))
+// into its individual functions, values without a function form are returned
+// whole. An empty or none value returns an empty list.
+func SplitValues(value string) []string {
+	value = strings.TrimSpace(value)
+
+	if value == "" || value == "none" {
+		return nil
+	}
+
+	if funcs := functionMatch.FindAllString(value, -1); len(funcs) > 0 {
+		return funcs
+	}
+
+	return []string{value}
+}
+
+// FunctionArgs returns the arguments of a css function value, eg
+// translate(10px, 20px) returns [10px 20px].
+func FunctionArgs(value string) []string {
+	start := strings.Index(value, "(")
+	end := strings.LastIndex(value, ")")
+
+	if start < 0 || end < start {
+		return nil
+	}
+
+	var args []string
+
+	for _, arg := range strings.Split(value[start+1:end], ",") {
+		if arg = strings.TrimSpace(arg); arg != "" {
+			args = append(args, arg)
+		}
+	}
+
+	return args
+}
+
+// Add sets the value of the property, replacing any previous value.
+func (c ComputedStyleMap) Add(name string, value string, priority bool) {
+	var vals []string
+
+	if functionListProperties[name] {
+		vals = SplitValues(value)
+	} else if strings.TrimSpace(value) != "none" {
+		vals = append(vals, value)
+	}
+
+	vendorName := name
+	if cs, ok := c[name]; ok {
+		vendorName = cs.VendorName
+	}
+
+	c[name] = &ComputedStyle{
+		Name:       name,
+		VendorName: vendorName,
+		Value:      value,
+		Values:     vals,
+		Priority:   priority,
+	}
+}
+
+// AddMore adds the value into the property's list of values, replacing any
+// existing value with the same function name (eg translateX(10px) replaces
+// translateX(5px)), which allows multi-value properties like transform to be
+// merged rather than replaced.
+func (c ComputedStyleMap) AddMore(name string, value string, priority bool) {
+	cs, ok := c[name]
 	if !ok {
-		return nil, ErrNotFound
+		c.Add(name, value, priority)
+		return
+	}
+
+	for _, val := range SplitValues(value) {
+		key := ValueName(val)
+		replaced := false
+
+		for index, old := range cs.Values {
+			if ValueName(old) == key {
+				cs.Values[index] = val
+				replaced = true
+				break
+			}
+		}
+
+		if !replaced {
+			cs.Values = append(cs.Values, val)
+		}
 	}
 
-	return cs, nil
+	cs.Value = strings.Join(cs.Values, " ")
+	cs.Priority = cs.Priority || priority
+}
+
+// AddCSSText adds the declarations of the giving cssText into the map, where
+// properties holding a function list are merged using AddMore while others
+// are replaced using Add. Declarations are split on the semicolons outside of
+// parentheses and quotes, keeping eg url(data:image/png;base64,...) whole.
+func (c ComputedStyleMap) AddCSSText(text string) {
+	for _, decl := range SplitTopLevel(text, ';') {
+		colon := strings.Index(decl, ":")
+		if colon < 0 {
+			continue
+		}
+
+		name := strings.TrimSpace(decl[:colon])
+		value := strings.TrimSpace(decl[colon+1:])
+
+		if name == "" {
+			continue
+		}
+
+		priority := strings.HasSuffix(value, "!important")
+		if priority {
+			value = strings.TrimSpace(strings.TrimSuffix(value, "!important"))
+		}
+
+		if functionListProperties[name] {
+			c.AddMore(name, value, priority)
+			continue
+		}
+
+		c.Add(name, value, priority)
+	}
+}
+
+// SplitTopLevel splits the value by the separator outside of any parentheses
+// or quotes, keeping functions like calc(1px + 2px) or rgba(0, 0, 0, 0.5)
+// whole. A space separator splits by any run of whitespace, dropping the
+// empty parts.
+func SplitTopLevel(value string, sep rune) []string {
+	var parts []string
+	var depth, start int
+	var quote rune
+
+	spaces := sep == ' '
+
+	for index, r := range value {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '(':
+			depth++
+		case r == ')' && depth > 0:
+			depth--
+		case depth == 0 && (r == sep || spaces && unicode.IsSpace(r)):
+			if !spaces || index > start {
+				parts = append(parts, value[start:index])
+			}
+
+			start = index + utf8.RuneLen(r)
+		}
+	}
+
+	if !spaces || len(value) > start {
+		parts = append(parts, value[start:])
+	}
+
+	return parts
+}
+
+// Diff returns a new map of the properties within this map which have a
+// different value or priority from the ones in the other map, including the
+// properties missing from the other map. Properties in the other map which no
+// longer exist in this map are included with an empty Value, to allow their
+// removal.
+func (c ComputedStyleMap) Diff(other ComputedStyleMap) ComputedStyleMap {
+	diff := make(ComputedStyleMap)
+
+	for name, cs := range c {
+		ocs, ok := other[name]
+		if ok && ocs.Value == cs.Value && ocs.Priority == cs.Priority {
+			continue
+		}
+
+		diff[name] = cs
+	}
+
+	for name, ocs := range other {
+		if _, ok := c[name]; ok {
+			continue
+		}
+
+		diff[name] = &ComputedStyle{
+			Name:       ocs.Name,
+			VendorName: ocs.VendorName,
+		}
+	}
+
+	return diff
+}
+
+// commaListProperties defines the properties whose multiple values are
+// separated by commas instead of spaces.
+var commaListProperties = map[string]bool{
+	"animation":        true,
+	"background":       true,
+	"background-image": true,
+	"box-shadow":       true,
+	"font-family":      true,
+	"text-shadow":      true,
+	"transition":       true,
+	"will-change":      true,
+}
+
+// String returns the map as a cssText string of `name: value;` pairs sorted by
+// property name, adding a `!important` suffix to prioritized properties.
+// Properties with an empty value are skipped.
+func (c ComputedStyleMap) String() string {
+	var names []string
+
+	for name := range c {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var css []string
+
+	for _, name := range names {
+		cs := c[name]
+
+		value := cs.Value
+		if len(cs.Values) > 0 {
+			if commaListProperties[cs.Name] {
+				value = strings.Join(cs.Values, ", ")
+			} else {
+				value = strings.Join(cs.Values, " ")
+			}
+		}
+
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+
+		if cs.Priority {
+			value += " !important"
+		}
+
+		css = append(css, fmt.Sprintf("%s: %s;", cs.Name, value))
+	}
+
+	return strings.Join(css, " ")
 }
 
 //==============================================================================
 
-// colorReg defines a regexp for matching rgb/rgba header content.
-var colorReg = regexp.MustCompile("[rgb|rgba]\\(([\\d\\.,\\s]+)\\)")
+// colorReg defines a regexp for matching rgb/rgba header content, anchored at
+// the start hence only the rgb/rgba function names, not eg grb(), match.
+var colorReg = regexp.MustCompile("^rgba?\\(([\\d\\.,\\s]+)\\)")
 
 // IsRGBFormat returns true/false if the giving string is a rgb/rgba format data.
 func IsRGBFormat(c string) bool {
-	return colorReg.MatchString(c)
+	return colorReg.MatchString(strings.TrimSpace(c))
 }
 
 // rgbHeader defines a regexp for matching rgb/rgba header content.
@@ -176,7 +664,7 @@ func IsRGBA(c string) bool {
 // ParseRGB pulls out the rgb/rgba information from a rgba(9,9,9,9) type
 // formatted string.
 func ParseRGB(rgbData string) (int, int, int, float64) {
-	subs := colorReg.FindStringSubmatch(rgbData)
+	subs := colorReg.FindStringSubmatch(strings.TrimSpace(rgbData))
 
 	if len(subs) < 2 {
 		return 0, 0, 0, 0
@@ -184,6 +672,10 @@ func ParseRGB(rgbData string) (int, int, int, float64) {
 
 	rc := strings.Split(subs[1], ",")
 
+	if len(rc) < 3 {
+		return 0, 0, 0, 0
+	}
+
 	var r, g, b int
 	var alpha float64
 
@@ -203,16 +695,36 @@ func ParseRGB(rgbData string) (int, int, int, float64) {
 // HexToRGB turns a hexademicmal color into rgba format.
 // Returns the read, green and blue values as int.
 func HexToRGB(hex string) (red, green, blue int) {
+	red, green, blue, _ = ToRGBA(hex)
+	return
+}
+
+// ToRGBA turns a hexadecimal color in either the 3, 4, 6 or 8 digit format into
+// its red, green, blue and alpha values, where alpha ranges from 0-255. Colors
+// without an alpha component have a full alpha of 255, while invalid colors
+// return zeros.
+func ToRGBA(hex string) (red, green, blue, alpha int) {
+	if !hexMatch.MatchString(hex) {
+		return
+	}
+
 	if strings.HasPrefix(hex, "#") {
 		hex = strings.TrimPrefix(hex, "#")
 	}
 
-	// We are dealing with a 3 string hex.
+	alpha = 255
+
+	// We are dealing with a 3 or 4 string hex.
 	if len(hex) < 6 {
 		parts := strings.Split(hex, "")
 		red = ParseIntBase16(doubleString(parts[0]))
 		green = ParseIntBase16(doubleString(parts[1]))
 		blue = ParseIntBase16(doubleString(parts[2]))
+
+		if len(parts) > 3 {
+			alpha = ParseIntBase16(doubleString(parts[3]))
+		}
+
 		return
 	}
 
@@ -220,21 +732,624 @@ func HexToRGB(hex string) (red, green, blue int) {
 	green = ParseIntBase16(hex[2:4])
 	blue = ParseIntBase16(hex[4:6])
 
+	if len(hex) >= 8 {
+		alpha = ParseIntBase16(hex[6:8])
+	}
+
 	return
 }
 
 // HexToRGBA turns a hexademicmal color into rgba format.
-// Alpha values ranges from 0-100
+// Alpha values ranges from 0-100, where values outside the range are clamped
+// into it, and are only used when the hex color has no alpha component of its
+// own(eg #rrggbbaa).
 func HexToRGBA(hex string, alpha int) string {
-	r, g, b := HexToRGB(hex)
-	return fmt.Sprintf("rgba(%d,%d,%d,%.2f)", r, g, b, float64(alpha)/100)
+	return HexToRGBAf(hex, float64(alpha)/100)
+}
+
+// HexToRGBAf turns a hexademicmal color into rgba format, taking the alpha in
+// the css range of 0-1, where values outside the range are clamped into it.
+// The alpha is only used when the hex color has no alpha component of its
+// own(eg #rrggbbaa).
+func HexToRGBAf(hex string, alpha float64) string {
+	r, g, b, a := ToRGBA(hex)
+
+	if hasHexAlpha(hex) {
+		alpha = float64(a) / 255
+	}
+
+	return fmt.Sprintf("rgba(%d,%d,%d,%s)", r, g, b, formatAlpha(alpha))
+}
+
+// formatAlpha returns the alpha clamped into the range of 0-1, formatted with
+// at most 3 decimal places and without trailing zeros.
+func formatAlpha(alpha float64) string {
+	if alpha < 0 || math.IsNaN(alpha) {
+		alpha = 0
+	}
+
+	if alpha > 1 {
+		alpha = 1
+	}
+
+	return strconv.FormatFloat(math.Round(alpha*1000)/1000, 'f', -1, 64)
+}
+
+// hasHexAlpha returns true/false if the hex color has an alpha component.
+func hasHexAlpha(hex string) bool {
+	hex = strings.TrimPrefix(hex, "#")
+	return len(hex) == 4 || len(hex) == 8
+}
+
+// ToRGB turns a color in either hexadecimal, rgb/rgba, hsl/hsla or named css
+// color format into its red, green and blue values. Unknown colors return 0 for
+// all values, use NamedColorToHex to tell an unknown name apart from black.
+func ToRGB(color string) (red, green, blue int) {
+	color = strings.TrimSpace(color)
+
+	if IsHSL(color) {
+		h, s, l, _ := ParseHSL(color)
+		return HSLToRGB(h, s, l)
+	}
+
+	if IsRGBFormat(color) {
+		red, green, blue, _ = ParseRGB(color)
+		return
+	}
+
+	if hex, ok := NamedColorToHex(color); ok {
+		return HexToRGB(hex)
+	}
+
+	if IsHex(color) {
+		return HexToRGB(color)
+	}
+
+	return
+}
+
+// hexMatch defines a regexp for matching hexadecimal color content.
+var hexMatch = regexp.MustCompile("^#?([\\da-fA-F]{3,4}|[\\da-fA-F]{6}|[\\da-fA-F]{8})$")
+
+// IsHex returns true/false if the giving string is a hexadecimal color data.
+func IsHex(c string) bool {
+	return hexMatch.MatchString(c)
+}
+
+// ToHex turns a rgb/rgba color into a lowercase hexadecimal color of the
+// format #rrggbb, dropping any alpha value. Returns ErrNotFound if the giving
+// string is not a valid rgb/rgba color.
+func ToHex(rgb string) (string, error) {
+	r, g, b, _, err := parseRGBChannels(rgb)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b), nil
+}
+
+// ToHexA turns a rgb/rgba color into a lowercase hexadecimal color of the
+// format #rrggbbaa, where a rgb color has a full alpha of ff. Returns
+// ErrNotFound if the giving string is not a valid rgb/rgba color.
+func ToHexA(rgb string) (string, error) {
+	r, g, b, alpha, err := parseRGBChannels(rgb)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("#%02x%02x%02x%02x", r, g, b, clampChannel(int(alpha*255+0.5))), nil
+}
+
+// parseRGBChannels returns the clamped channels of a rgb/rgba color else
+// returns ErrNotFound if it has less than three channels.
+func parseRGBChannels(rgb string) (int, int, int, float64, error) {
+	subs := colorReg.FindStringSubmatch(strings.TrimSpace(rgb))
+	if len(subs) < 2 || len(strings.Split(subs[1], ",")) < 3 {
+		return 0, 0, 0, 0, ErrNotFound
+	}
+
+	r, g, b, alpha := ParseRGB(rgb)
+	return clampChannel(r), clampChannel(g), clampChannel(b), alpha, nil
+}
+
+// clampChannel clamps the giving value into the 0-255 range of a color
+// channel.
+func clampChannel(c int) int {
+	if c < 0 {
+		return 0
+	}
+
+	if c > 255 {
+		return 255
+	}
+
+	return c
+}
+
+//==============================================================================
+
+// hslHeader defines a regexp for matching hsl/hsla header content.
+var hslHeader = regexp.MustCompile("hsla?\\(([\\d\\.,\\s%]+)\\)")
+
+// IsHSL returns true/false if the giving string is a hsl/hsla format data.
+func IsHSL(c string) bool {
+	return hslHeader.MatchString(c)
+}
+
+// ParseHSL pulls out the hsl/hsla information from a hsla(210,50%,40%,0.5)
+// type formatted string. Percentage signs on the saturation and lightness
+// components are optional.
+func ParseHSL(hslData string) (int, int, int, float64) {
+	subs := hslHeader.FindStringSubmatch(hslData)
+
+	if len(subs) < 2 {
+		return 0, 0, 0, 0
+	}
+
+	hc := strings.Split(subs[1], ",")
+
+	if len(hc) < 3 {
+		return 0, 0, 0, 0
+	}
+
+	var h, s, l int
+	var alpha float64
+
+	h = ParseInt(hc[0])
+	s = ParseInt(hc[1])
+	l = ParseInt(hc[2])
+
+	if len(hc) > 3 {
+		alpha = ParseFloat(hc[3])
+	} else {
+		alpha = 1
+	}
+
+	return h, s, l, alpha
+}
+
+// ToHSL turns a color into its hue, saturation and lightness values. Colors
+// already in hsl/hsla format are read directly, others are converted through
+// ToRGB.
+func ToHSL(color string) (hue, saturation, lightness int) {
+	if IsHSL(color) {
+		hue, saturation, lightness, _ = ParseHSL(color)
+		return
+	}
+
+	return RGBToHSL(ToRGB(color))
+}
+
+// HSLA turns a color into hsla format.
+// Alpha values ranges from 0-100
+func HSLA(color string, alpha int) string {
+	h, s, l := ToHSL(color)
+	return fmt.Sprintf("hsla(%d,%d%%,%d%%,%s)", h, s, l, formatAlpha(float64(alpha)/100))
+}
+
+// HSLToRGB turns a hue(0-360), saturation(0-100) and lightness(0-100) set into
+// its red, green and blue values.
+func HSLToRGB(hue, saturation, lightness int) (red, green, blue int) {
+	h := float64(((hue%360)+360)%360) / 360
+	s := float64(saturation) / 100
+	l := float64(lightness) / 100
+
+	if s == 0 {
+		gray := int(l*255 + 0.5)
+		return gray, gray, gray
+	}
+
+	var q float64
+	if l < 0.5 {
+		q = l * (1 + s)
+	} else {
+		q = l + s - (l * s)
+	}
+
+	p := (2 * l) - q
+
+	red = int(hueToChannel(p, q, h+(1.0/3))*255 + 0.5)
+	green = int(hueToChannel(p, q, h)*255 + 0.5)
+	blue = int(hueToChannel(p, q, h-(1.0/3))*255 + 0.5)
+	return
+}
+
+// RGBToHSL turns a red, green and blue set into its hue(0-360),
+// saturation(0-100) and lightness(0-100) values.
+func RGBToHSL(red, green, blue int) (hue, saturation, lightness int) {
+	r := float64(red) / 255
+	g := float64(green) / 255
+	b := float64(blue) / 255
+
+	max := math.Max(r, math.Max(g, b))
+	min := math.Min(r, math.Min(g, b))
+
+	l := (max + min) / 2
+	lightness = int(l*100 + 0.5)
+
+	if max == min {
+		return 0, 0, lightness
+	}
+
+	d := max - min
+
+	var s float64
+	if l > 0.5 {
+		s = d / (2 - max - min)
+	} else {
+		s = d / (max + min)
+	}
+
+	var h float64
+	switch max {
+	case r:
+		h = (g - b) / d
+		if g < b {
+			h += 6
+		}
+	case g:
+		h = ((b - r) / d) + 2
+	default:
+		h = ((r - g) / d) + 4
+	}
+
+	hue = int(h*60+0.5) % 360
+	saturation = int(s*100 + 0.5)
+	return
+}
+
+// hueToChannel returns the value of a single rgb channel for the giving hue
+// offset.
+func hueToChannel(p, q, t float64) float64 {
+	if t < 0 {
+		t++
+	}
+
+	if t > 1 {
+		t--
+	}
+
+	switch {
+	case t < 1.0/6:
+		return p + (q-p)*6*t
+	case t < 1.0/2:
+		return q
+	case t < 2.0/3:
+		return p + (q-p)*(2.0/3-t)*6
+	}
+
+	return p
+}
+
+//==============================================================================
+
+// ColorMode defines the color space used when interpolating between colors.
+type ColorMode int
+
+// contains the different color spaces supported by LerpColor.
+const (
+	RGBMode ColorMode = iota
+	HSLMode
+)
+
+// LerpColor returns the rgba color found at t(0..1) between the from and to
+// colors, which can be in any format supported by ToRGB. Colors are
+// interpolated in the RGB space unless a HSLMode is provided, where the hue
+// takes the shortest path around the color wheel. The alpha is written with
+// at most 3 decimal places, as HexToRGBA writes it.
+func LerpColor(from, to string, t float64, mode ...ColorMode) string {
+	if t < 0 {
+		t = 0
+	}
+
+	if t > 1 {
+		t = 1
+	}
+
+	fr, fg, fb, fa := colorChannels(from)
+	tr, tg, tb, ta := colorChannels(to)
+
+	alpha := lerp(fa, ta, t)
+
+	if len(mode) > 0 && mode[0] == HSLMode {
+		fh, fs, fl := RGBToHSL(fr, fg, fb)
+		th, ts, tl := RGBToHSL(tr, tg, tb)
+
+		// Take the shortest path around the hue wheel.
+		if th-fh > 180 {
+			fh += 360
+		} else if fh-th > 180 {
+			th += 360
+		}
+
+		r, g, b := HSLToRGB(lerpInt(fh, th, t), lerpInt(fs, ts, t), lerpInt(fl, tl, t))
+		return fmt.Sprintf("rgba(%d,%d,%d,%s)", r, g, b, formatAlpha(alpha))
+	}
+
+	return fmt.Sprintf("rgba(%d,%d,%d,%s)", lerpInt(fr, tr, t), lerpInt(fg, tg, t), lerpInt(fb, tb, t), formatAlpha(alpha))
+}
+
+// colorChannels returns the red, green, blue and alpha(0..1) values of the
+// giving color.
+func colorChannels(color string) (int, int, int, float64) {
+	color = strings.TrimSpace(color)
+
+	switch {
+	case strings.EqualFold(color, "transparent"):
+		return 0, 0, 0, 0
+	case IsHSL(color):
+		h, s, l, alpha := ParseHSL(color)
+		r, g, b := HSLToRGB(h, s, l)
+		return r, g, b, alpha
+	case IsRGBFormat(color):
+		return ParseRGB(color)
+	}
+
+	if IsHex(color) {
+		r, g, b, a := ToRGBA(color)
+		return r, g, b, float64(a) / 255
+	}
+
+	r, g, b := ToRGB(color)
+	return r, g, b, 1
+}
+
+// lerp returns the value found at t(0..1) between from and to.
+func lerp(from, to, t float64) float64 {
+	return from + ((to - from) * t)
+}
+
+// relativeOperators defines the operators of relative values.
+var relativeOperators = []string{"+=", "-=", "*="}
+
+// ResolveRelative resolves the target against the start for the relative
+// operator, where += adds the target to the start, -= subtracts it from the
+// start and *= multiplies the start by it. Any other operator returns the
+// target as an absolute value.
+func ResolveRelative(start, target float64, op string) float64 {
+	switch op {
+	case "+=":
+		return start + target
+	case "-=":
+		return start - target
+	case "*=":
+		return start * target
+	}
+
+	return target
+}
+
+// SplitRelative separates the relative operator of the value, eg +=20px
+// returns += and 20px. Values without an operator return an empty operator.
+func SplitRelative(value string) (string, string) {
+	value = strings.TrimSpace(value)
+
+	for _, op := range relativeOperators {
+		if strings.HasPrefix(value, op) {
+			return op, strings.TrimSpace(value[len(op):])
+		}
+	}
+
+	return "", value
+}
+
+// RelativeValue resolves a relative css value target against the start
+// value, eg a target of +=20px from a start of 10px returns 30px. Deltas are
+// applied in the unit of the start, hence a delta in another unit can not be
+// resolved and keeps the start as it is rather than jumping to the delta.
+// Targets without a relative operator are returned as they are.
+func RelativeValue(start, target string) string {
+	op, value := SplitRelative(target)
+	if op == "" {
+		return value
+	}
+
+	sm, sunit, serr := ParseUnit(start)
+	tm, tunit, terr := ParseUnit(value)
+
+	if serr != nil || terr != nil {
+		return value
+	}
+
+	if op != "*=" && tunit != "" && tunit != sunit {
+		return start
+	}
+
+	return FormatUnit(ResolveRelative(sm, tm, op), sunit)
+}
+
+// ClampValue returns the value bounded by the min and max of the clamp, where
+// a zero clamp leaves the value as it is. Sequences clamp the values they
+// write rather than their interpolation, hence an overshooting easing keeps
+// the shape of its curve wherever it stays within the clamp.
+func ClampValue(value float64, clamp [2]float64) float64 {
+	if clamp == [2]float64{} {
+		return value
+	}
+
+	return math.Max(clamp[0], math.Min(clamp[1], value))
+}
+
+// lerpInt returns the rounded value found at t(0..1) between from and to.
+func lerpInt(from, to int, t float64) int {
+	return int(math.Floor(lerp(float64(from), float64(to), t) + 0.5))
+}
+
+// IsColor returns true/false if the giving value is a color in any of the
+// formats supported by LerpColor.
+func IsColor(value string) bool {
+	value = strings.TrimSpace(value)
+
+	if strings.EqualFold(value, "transparent") {
+		return true
+	}
+
+	if _, ok := NamedColorToHex(value); ok {
+		return true
+	}
+
+	// Hex colors require their hash here, as plain numbers are valid hex.
+	return IsHSL(value) || IsRGBFormat(value) || (strings.HasPrefix(value, "#") && IsHex(value))
+}
+
+// LerpValue returns the css value found at t(0..1) between the from and to
+// values. Colors are interpolated with LerpColor and unit values with their
+// magnitudes in the unit of the to value, while values of differing units,
+// which can not be converted without an element, and any other values switch
+// from one to the other at the halfway point.
+func LerpValue(from, to string, t float64) string {
+	if IsColor(from) && IsColor(to) {
+		return LerpColor(from, to, t)
+	}
+
+	fm, funit, ferr := ParseUnit(from)
+	tm, tunit, terr := ParseUnit(to)
+
+	if ferr == nil && terr == nil && (funit == tunit || funit == "" || tunit == "") {
+		if tunit == "" {
+			tunit = funit
+		}
+
+		return fmt.Sprintf("%.2f%s", lerp(fm, tm, t), tunit)
+	}
+
+	if t < 0.5 {
+		return from
+	}
+
+	return to
 }
 
+//==============================================================================
+
 // vendorTags provides a lists of different browser specific vendor names.
-var vendorTags = []string{"moz", "webki", "O", "ms"}
+var vendorTags = []string{"moz", "webkit", "o", "ms"}
+
+// Unvendor returns the property name stripped of any vendor prefix, eg
+// -webkit-transform returns transform. The prefix is matched regardless of
+// case.
+func Unvendor(u string) string {
+	lu := strings.ToLower(u)
+
+	for _, vn := range vendorTags {
+		prefix := fmt.Sprintf("-%s-", vn)
+		if strings.HasPrefix(lu, prefix) {
+			return u[len(prefix):]
+		}
+	}
+
+	return u
+}
+
+// prefixedProperties defines the properties which some browsers still only
+// apply in their vendor prefixed form, with the vendors requiring them.
+var prefixedProperties = map[string][]string{
+	"appearance":       {"moz", "webkit"},
+	"backdrop-filter":  {"webkit"},
+	"clip-path":        {"webkit"},
+	"mask-image":       {"webkit"},
+	"transform":        {"webkit", "ms"},
+	"transform-origin": {"webkit", "ms"},
+	"user-select":      {"moz", "webkit", "ms"},
+}
+
+// rewriteStyles returns the css text with the perspective in pixels, when
+// positive, set as the leading function of its transform and, when prefixing,
+// the vendor prefixed forms of the properties requiring them added ahead of
+// their standard forms. The text is only parsed once a cheap scan finds any
+// of the properties rewritten, returning false when it was left as is.
+func rewriteStyles(text string, perspective float64, prefix bool) (string, bool) {
+	scan := perspective > 0 && strings.Contains(text, "transform")
+
+	if prefix && !scan {
+		for name := range prefixedProperties {
+			if strings.Contains(text, name) {
+				scan = true
+				break
+			}
+		}
+	}
+
+	if !scan {
+		return text, false
+	}
+
+	styles := make(ComputedStyleMap)
+	styles.AddCSSText(text)
+
+	var rewritten bool
+
+	if perspective > 0 {
+		rewritten = withPerspective(styles, perspective)
+	}
+
+	if prefix && vendorPrefixed(styles) {
+		rewritten = true
+	}
+
+	if !rewritten {
+		return text, false
+	}
+
+	// The prefixed names sort ahead of the standard names.
+	return styles.String(), true
+}
+
+// vendorPrefixed adds the vendor prefixed forms of the properties requiring
+// them to the styles, returning false when they hold none of the properties
+// lacking them.
+func vendorPrefixed(styles ComputedStyleMap) bool {
+	var prefixed bool
+
+	for name, vendors := range prefixedProperties {
+		cs, ok := styles[name]
+		if !ok {
+			continue
+		}
+
+		for _, vendored := range Vendorize(name) {
+			for _, vn := range vendors {
+				if !strings.HasPrefix(vendored, "-"+vn+"-") {
+					continue
+				}
+
+				if _, ok := styles[vendored]; !ok {
+					vs := *cs
+					vs.Name = vendored
+					styles[vendored] = &vs
+					prefixed = true
+				}
+			}
+		}
+	}
+
+	return prefixed
+}
+
+// withPerspective sets the perspective in pixels as the leading function of
+// the transform of the styles, which the 3d functions of the transform are
+// projected through, returning false when they hold no transform.
+func withPerspective(styles ComputedStyleMap, perspective float64) bool {
+	cs, ok := styles["transform"]
+	if !ok || len(cs.Values) == 0 || strings.TrimSpace(cs.Value) == "none" {
+		return false
+	}
+
+	values := []string{fmt.Sprintf("perspective(%gpx)", perspective)}
+	for _, val := range cs.Values {
+		if ValueName(val) != "perspective" {
+			values = append(values, val)
+		}
+	}
+
+	cs.Values = values
+	cs.Value = strings.Join(values, " ")
+
+	return true
+}
 
 // Vendorize returns a property name with the different versions known according
-// browsers.
+// browsers, with the standard unprefixed name as the last item.
 func Vendorize(u string) []string {
 	var v []string
 
@@ -242,26 +1357,141 @@ func Vendorize(u string) []string {
 		v = append(v, fmt.Sprintf("-%s-%s", vn, u))
 	}
 
-	return v
+	return append(v, u)
 }
 
 // Unit returns a valid unit type in the browser, if the supplied unit is
 // standard then it is return else 'px' is returned as default.
 func Unit(u string) string {
-	switch u {
-	case "rem":
-		return u
-	case "em":
-		return u
-	case "px":
-		return u
-	case "%":
-		return u
+	unit, _ := KnownUnit(u)
+	return unit
+}
+
+// KnownUnit returns the giving unit and true if it is a standard unit type in
+// the browser, else returns the 'px' default and false.
+func KnownUnit(u string) (string, bool) {
+	if !cssUnits[u] {
+		return "px", false
+	}
+
+	return u, true
+}
+
+// cssUnits defines the set of standard css units recognized by Unit and
+// ParseUnit.
+var cssUnits = map[string]bool{
+	"px":   true,
+	"em":   true,
+	"rem":  true,
+	"%":    true,
+	"vw":   true,
+	"vh":   true,
+	"vmin": true,
+	"vmax": true,
+	"ch":   true,
+	"ex":   true,
+	"pt":   true,
+}
+
+// ErrInvalidUnit is returned when a value is not a valid css unit value.
+var ErrInvalidUnit = errors.New("Invalid Unit")
+
+// unitValueMatch defines a regexp for matching a unit value eg -42.5px.
+var unitValueMatch = regexp.MustCompile("^([+-]?(?:\\d+\\.?\\d*|\\.\\d+))([a-zA-Z%]*)$")
+
+// ParseUnit separates a css unit value into its numeric magnitude and unit
+// suffix, eg 42.5px returns 42.5 and px. Unitless numbers return an empty
+// unit. Returns ErrInvalidUnit if the value is malformed or its unit is not
+// one of the units known to Unit.
+func ParseUnit(value string) (float64, string, error) {
+	subs := unitValueMatch.FindStringSubmatch(strings.TrimSpace(value))
+	if len(subs) < 3 {
+		return 0, "", ErrInvalidUnit
+	}
+
+	unit := strings.ToLower(subs[2])
+	if unit != "" && !cssUnits[unit] {
+		return 0, "", ErrInvalidUnit
+	}
+
+	magnitude, err := strconv.ParseFloat(subs[1], 64)
+	if err != nil {
+		return 0, "", ErrInvalidUnit
+	}
+
+	return magnitude, unit, nil
+}
+
+// ResolveUnit resolves the giving css unit value into pixels, viewport units
+// are resolved against the current Window, percentages against the width of
+// the element's parent box, em against the element's font-size and rem
+// against the root element's font-size. A calc expression is evaluated with
+// EvalCalc, resolving its percentages against the width of the element's
+// parent box. Returns ErrInvalidUnit if the value is malformed or can not be
+// resolved.
+func ResolveUnit(value string, elem dom.Element) (float64, error) {
+	if strings.HasPrefix(strings.ToLower(strings.TrimSpace(value)), "calc(") {
+		var basis float64
+		if parent := elem.ParentElement(); parent != nil {
+			basis = parent.GetBoundingClientRect().Width
+		}
+
+		return EvalCalc(value, basis)
+	}
+
+	magnitude, unit, err := ParseUnit(value)
+	if err != nil {
+		return 0, err
+	}
+
+	switch unit {
+	case "", "px":
+		return magnitude, nil
+	case "pt":
+		return magnitude * 96 / 72, nil
 	case "vw":
-		return u
-	default:
-		return "px"
+		return magnitude * float64(Window().InnerWidth()) / 100, nil
+	case "vh":
+		return magnitude * float64(Window().InnerHeight()) / 100, nil
+	case "vmin":
+		return magnitude * math.Min(float64(Window().InnerWidth()), float64(Window().InnerHeight())) / 100, nil
+	case "vmax":
+		return magnitude * math.Max(float64(Window().InnerWidth()), float64(Window().InnerHeight())) / 100, nil
+	case "%":
+		parent := elem.ParentElement()
+		if parent == nil {
+			return 0, ErrInvalidUnit
+		}
+
+		return magnitude * parent.GetBoundingClientRect().Width / 100, nil
+	case "em":
+		return magnitude * fontSize(elem), nil
+	case "rem":
+		return magnitude * fontSize(Document().DocumentElement()), nil
+	}
+
+	return 0, ErrInvalidUnit
+}
+
+// fontSize returns the computed font-size of the element in pixels.
+func fontSize(elem dom.Element) float64 {
+	styles, err := GetComputedStyleMap(elem, "")
+	if err != nil {
+		return 0
+	}
+
+	size, err := styles.Get("font-size")
+	if err != nil {
+		return 0
 	}
+
+	return ParseFloat(size.Value)
+}
+
+// FormatUnit returns the css unit value for the giving magnitude and unit,
+// eg 42.5 and px returns 42.5px.
+func FormatUnit(magnitude float64, unit string) string {
+	return strconv.FormatFloat(magnitude, 'f', -1, 64) + unit
 }
 
 // doubleString doubles the giving string.
@@ -271,16 +1501,30 @@ func doubleString(c string) string {
 
 //==============================================================================
 
+// signedMatch defines a regexp for matching a signed float within the
+// arguments of a transform function.
+var signedMatch = regexp.MustCompile("-?(\\d+\\.?\\d*|\\.\\d+)(e[-+]?\\d+)?")
+
+// parseSigned parses the first signed number within the transform argument
+// into a float, unlike ParseFloat which drops its sign, returning 0 if it has
+// none.
+func parseSigned(arg string) float64 {
+	fl, _ := strconv.ParseFloat(signedMatch.FindString(arg), 64)
+	return fl
+}
+
 // simpleRotationMatch defines a matcher for the formation rotate(90deg).
-var simpleRotationMatch = regexp.MustCompile("rotate\\(([\\d]+)deg\\)")
+var simpleRotationMatch = regexp.MustCompile("rotate\\((-?[\\d\\.]+)deg\\)")
 
 // IsSimpleRotation checks wether the giving string is a css rotation directive.
 func IsSimpleRotation(data string) bool {
 	return simpleRotationMatch.MatchString(data)
 }
 
-// rotationMatch defines a matcher for the formation rotate(90deg).
-var rotationMatch = regexp.MustCompile("[rotate|rotateX|rotateY|rotateZ]\\(([\\d]+)deg\\)")
+// rotationMatch defines a matcher for the formation rotate(90deg), also
+// matching the rotateX, rotateY and rotateZ forms and the turn, rad and grad
+// angle units.
+var rotationMatch = regexp.MustCompile("rotate[XYZ]?\\((-?[\\d\\.]+)(deg|turn|rad|grad)?\\)")
 
 // Rotation defines the concrete representation of the css3 skew
 // transform property.
@@ -294,16 +1538,26 @@ func IsRotation(data string) bool {
 }
 
 // ToRotation returns the rotation from the giving string else returns
-// an error if it failed.
+// an error if it failed. The angle of the rotation is always returned in
+// degrees.
 func ToRotation(data string) (*Rotation, error) {
 	if !IsRotation(data) {
 		return nil, errors.New("Invalid Data")
 	}
 
-	ts := strings.Split(rotationMatch.FindStringSubmatch(data)[1], ",")
+	subs := rotationMatch.FindStringSubmatch(data)
 
 	var t Rotation
-	t.Angle = ParseFloat(ts[0])
+	t.Angle = parseSigned(subs[1])
+
+	switch subs[2] {
+	case "turn":
+		t.Angle = t.Angle * 360
+	case "rad":
+		t.Angle = t.Angle * (180 / math.Pi)
+	case "grad":
+		t.Angle = t.Angle * 0.9
+	}
 
 	return &t, nil
 }
@@ -337,12 +1591,12 @@ func ToSkew(data string) (*Skew, error) {
 	var t Skew
 
 	if strings.HasSuffix(data, "Y") {
-		t.Y = ParseFloat(ts[0])
+		t.Y = parseSigned(ts[0])
 	} else if strings.Contains(data, "X") {
-		t.X = ParseFloat(ts[0])
+		t.X = parseSigned(ts[0])
 	} else {
-		t.X = ParseFloat(ts[0])
-		t.Y = ParseFloat(ts[1])
+		t.X = parseSigned(ts[0])
+		t.Y = parseSigned(ts[1])
 	}
 
 	return &t, nil
@@ -350,7 +1604,9 @@ func ToSkew(data string) (*Skew, error) {
 
 //==============================================================================
 
-var scaleMatch = regexp.MustCompile("[translate|translateX|translateY]\\(([\\d,\\s]+)\\)")
+// scaleMatch defines a matcher for the format eg scale(1.5, 2), also matching
+// the scaleX and scaleY forms.
+var scaleMatch = regexp.MustCompile("scale([XY])?\\(([-\\d\\.,\\se]+)\\)")
 
 // Scale defines the concrete representation of the css3 scale
 // transform property.
@@ -364,24 +1620,30 @@ func IsScale(data string) bool {
 	return scaleMatch.MatchString(data)
 }
 
-// ToScale returns the translation from the giving string else returns
-// an error if it failed.
+// ToScale returns the scale from the giving string else returns
+// an error if it failed. Axes not set by the directive default to 1.
 func ToScale(data string) (*Scale, error) {
 	if !IsScale(data) {
 		return nil, errors.New("Invalid Data")
 	}
 
-	ts := strings.Split(scaleMatch.FindStringSubmatch(data)[1], ",")
+	subs := scaleMatch.FindStringSubmatch(data)
+	ts := strings.Split(subs[2], ",")
 
-	var t Scale
+	t := Scale{X: 1, Y: 1}
 
-	if strings.HasSuffix(data, "Y") {
-		t.Y = ParseFloat(ts[0])
-	} else if strings.Contains(data, "X") {
-		t.X = ParseFloat(ts[0])
-	} else {
-		t.X = ParseFloat(ts[0])
-		t.Y = ParseFloat(ts[1])
+	switch subs[1] {
+	case "X":
+		t.X = parseSigned(ts[0])
+	case "Y":
+		t.Y = parseSigned(ts[0])
+	default:
+		t.X = parseSigned(ts[0])
+		t.Y = t.X
+
+		if len(ts) > 1 {
+			t.Y = parseSigned(ts[1])
+		}
 	}
 
 	return &t, nil
@@ -444,12 +1706,12 @@ func ToTranslation(data string) (*Translation, error) {
 	var t Translation
 
 	if strings.HasSuffix(data, "Y") {
-		t.Y = ParseFloat(ts[0])
+		t.Y = parseSigned(ts[0])
 	} else if strings.Contains(data, "X") {
-		t.X = ParseFloat(ts[0])
+		t.X = parseSigned(ts[0])
 	} else {
-		t.X = ParseFloat(ts[0])
-		t.Y = ParseFloat(ts[1])
+		t.X = parseSigned(ts[0])
+		t.Y = parseSigned(ts[1])
 	}
 
 	return &t, nil
@@ -457,7 +1719,7 @@ func ToTranslation(data string) (*Translation, error) {
 
 //==============================================================================
 
-var matrixMatch = regexp.MustCompile("matrix(3[d|D])?\\(([,\\d\\s]+)\\)")
+var matrixMatch = regexp.MustCompile("matrix(3[dD])?\\(([-,\\d\\.\\se]+)\\)")
 
 // IsMatrix returns true/false if the giving string is a matrix declaration.
 func IsMatrix(data string) bool {
@@ -465,7 +1727,7 @@ func IsMatrix(data string) bool {
 		return false
 	}
 
-	ms := strings.Split(matrixMatch.FindStringSubmatch(data)[1], ",")
+	ms := strings.Split(matrixMatch.FindStringSubmatch(data)[2], ",")
 
 	if len(ms) < 6 {
 		return false
@@ -494,23 +1756,180 @@ func ToMatrix2D(data string) (*Matrix, error) {
 		return nil, errors.New("Invalid Matrix data")
 	}
 
-	ms := strings.Split(matrixMatch.FindStringSubmatch(data)[1], ",")
+	ms := strings.Split(matrixMatch.FindStringSubmatch(data)[2], ",")
 
 	if len(ms) < 6 {
 		return nil, errors.New("Invalid Matrix data")
 	}
 
+	// A matrix3d holds its positions within the last row.
+	if len(ms) == 16 {
+		m := Matrix{
+			ScaleX:    parseSigned(ms[0]),
+			ScaleY:    parseSigned(ms[5]),
+			ScaleZ:    parseSigned(ms[10]),
+			PositionX: parseSigned(ms[12]),
+			PositionY: parseSigned(ms[13]),
+			PositionZ: parseSigned(ms[14]),
+		}
+
+		return &m, nil
+	}
+
 	m := Matrix{
-		ScaleX:    ParseFloat(ms[0]),
-		RotationX: ParseFloat(ms[1]),
-		ScaleY:    ParseFloat(ms[2]),
-		RotationY: ParseFloat(ms[3]),
-		PositionX: ParseFloat(ms[4]),
-		PositionY: ParseFloat(ms[5]),
+		ScaleX:    parseSigned(ms[0]),
+		RotationX: parseSigned(ms[1]),
+		ScaleY:    parseSigned(ms[2]),
+		RotationY: parseSigned(ms[3]),
+		PositionX: parseSigned(ms[4]),
+		PositionY: parseSigned(ms[5]),
 	}
 
 	return &m, nil
 }
 
+// DecomposeMatrix decomposes a 2d transform matrix, eg the computed transform
+// of an element, into the translation, rotation and skew in degrees and
+// scale producing it, following the 2d decomposition of the css transforms
+// spec. The 2d components of a matrix3d are decomposed, ignoring its depth,
+// and none decomposes into the identity. Returns an error if the value is
+// not a matrix or the matrix can not be decomposed.
+func DecomposeMatrix(value string) (translateX, translateY, rotate, scaleX, scaleY, skew float64, err error) {
+	if strings.TrimSpace(value) == "none" {
+		return 0, 0, 0, 1, 1, 0, nil
+	}
+
+	if !IsMatrix(value) {
+		return 0, 0, 0, 0, 0, 0, errors.New("Invalid Matrix data")
+	}
+
+	ms := strings.Split(matrixMatch.FindStringSubmatch(value)[2], ",")
+
+	var a, b, c, d float64
+
+	switch len(ms) {
+	case 6:
+		a, b, c, d = parseSigned(ms[0]), parseSigned(ms[1]), parseSigned(ms[2]), parseSigned(ms[3])
+		translateX, translateY = parseSigned(ms[4]), parseSigned(ms[5])
+	case 16:
+		a, b, c, d = parseSigned(ms[0]), parseSigned(ms[1]), parseSigned(ms[4]), parseSigned(ms[5])
+		translateX, translateY = parseSigned(ms[12]), parseSigned(ms[13])
+	default:
+		return 0, 0, 0, 0, 0, 0, errors.New("Invalid Matrix data")
+	}
+
+	if a*d-b*c == 0 {
+		return 0, 0, 0, 0, 0, 0, errors.New("Matrix can not be decomposed")
+	}
+
+	scaleX = math.Hypot(a, b)
+	a, b = a/scaleX, b/scaleX
+
+	shear := a*c + b*d
+	c, d = c-a*shear, d-b*shear
+
+	scaleY = math.Hypot(c, d)
+	shear /= scaleY
+
+	// A flipped matrix is decomposed as a negative horizontal scale.
+	if a*d-b*c < 0 {
+		a, b = -a, -b
+		shear = -shear
+		scaleX = -scaleX
+	}
+
+	rotate = math.Atan2(b, a) * 180 / math.Pi
+	skew = math.Atan(shear) * 180 / math.Pi
+
+	return translateX, translateY, rotate, scaleX, scaleY, skew, nil
+}
+
+// transformFunctions returns the functions of a transform value in the form
+// the transform sequences write them, where a matrix is decomposed into its
+// translateX, translateY, rotate, skewX and scale functions, leaving out those
+// without effect, and translate is split into translateX and translateY. A
+// transform of none and a translate without arguments hold no functions.
+func transformFunctions(value string) []string {
+	var funcs []string
+
+	for _, fn := range SplitValues(value) {
+		switch ValueName(fn) {
+		case "none":
+		case "matrix", "matrix3d":
+			tx, ty, rotate, sx, sy, skew, err := DecomposeMatrix(fn)
+			if err != nil {
+				funcs = append(funcs, fn)
+				continue
+			}
+
+			if tx != 0 {
+				funcs = append(funcs, fmt.Sprintf("translateX(%.2fpx)", tx))
+			}
+
+			if ty != 0 {
+				funcs = append(funcs, fmt.Sprintf("translateY(%.2fpx)", ty))
+			}
+
+			if math.Abs(rotate) > 1e-6 {
+				funcs = append(funcs, fmt.Sprintf("rotate(%.2fdeg)", rotate))
+			}
+
+			if math.Abs(skew) > 1e-6 {
+				funcs = append(funcs, fmt.Sprintf("skewX(%.2fdeg)", skew))
+			}
+
+			if math.Abs(sx-1) > 1e-6 || math.Abs(sy-1) > 1e-6 {
+				funcs = append(funcs, fmt.Sprintf("scale(%.2f, %.2f)", sx, sy))
+			}
+		case "translate":
+			// The arguments of translate may be separated by whitespace as
+			// well as commas, eg translate(10px 20px).
+			var args []string
+			for _, arg := range FunctionArgs(fn) {
+				args = append(args, strings.Fields(arg)...)
+			}
+
+			if len(args) == 0 {
+				continue
+			}
+
+			funcs = append(funcs, "translateX("+args[0]+")")
+
+			if len(args) > 1 {
+				funcs = append(funcs, "translateY("+args[1]+")")
+			}
+		default:
+			funcs = append(funcs, fn)
+		}
+	}
+
+	return funcs
+}
+
+// ComposeMatrix composes the 2d transform matrix from its translation,
+// rotation and skew in degrees and scale, being the inverse of
+// DecomposeMatrix.
+func ComposeMatrix(translateX, translateY, rotate, scaleX, scaleY, skew float64) string {
+	rad := rotate * math.Pi / 180
+	cos, sin := math.Cos(rad), math.Sin(rad)
+	shear := math.Tan(skew * math.Pi / 180)
+
+	values := []float64{
+		scaleX * cos,
+		scaleX * sin,
+		scaleY * (shear*cos - sin),
+		scaleY * (shear*sin + cos),
+		translateX,
+		translateY,
+	}
+
+	parts := make([]string, len(values))
+	for index, val := range values {
+		parts[index] = strconv.FormatFloat(math.Round(val*1e6)/1e6, 'f', -1, 64)
+	}
+
+	return "matrix(" + strings.Join(parts, ", ") + ")"
+}
+
 // type Matrix3D [3]*Matrix2D
 //==============================================================================
@@ -0,0 +1,518 @@
+package govfx_test
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/influx6/govfx"
+	"honnef.co/go/js/dom"
+)
+
+// TestUnvendor validates the behaviour of the Unvendor function.
+func TestUnvendor(t *testing.T) {
+	names := map[string]string{
+		"-webkit-transform": "transform",
+		"-Webkit-transform": "transform",
+		"-moz-appearance":   "appearance",
+		"-o-transition":     "transition",
+		"-ms-user-select":   "user-select",
+		"transform":         "transform",
+		"opacity":           "opacity",
+		"order":             "order",
+		"mso-transform":     "mso-transform",
+	}
+
+	for name, expected := range names {
+		if unvendored := govfx.Unvendor(name); unvendored != expected {
+			t.Errorf("Expected Unvendor(%q) to be %q but got %q", name, expected, unvendored)
+		}
+	}
+}
+
+// TestVendorize validates the behaviour of the Vendorize function.
+func TestVendorize(t *testing.T) {
+	names := govfx.Vendorize("transform")
+
+	if names[len(names)-1] != "transform" {
+		t.Fatalf("Expected standard name as last item but got %q", names[len(names)-1])
+	}
+
+	for _, name := range names {
+		if unvendored := govfx.Unvendor(name); unvendored != "transform" {
+			t.Errorf("Expected %q to unvendor to %q but got %q", name, "transform", unvendored)
+		}
+	}
+}
+
+// vendoredStyles provides a govfx.StyleProvider returning its possibly vendor
+// prefixed declarations the way the window reports them, by their prefixed
+// names.
+type vendoredStyles map[string]string
+
+// ComputedStyle returns the declarations added under their unvendored names.
+func (v vendoredStyles) ComputedStyle(dom.Element, string) (govfx.ComputedStyleMap, error) {
+	styles := make(govfx.ComputedStyleMap)
+
+	for name, value := range v {
+		styles.AddVendored(name, value, false)
+	}
+
+	return styles, nil
+}
+
+// TestComputedStyleMapUnvendor validates the behaviour of GetComputedStyleMap
+// with the vendor prefixed properties of its provider, which are found under
+// their unvendored names.
+func TestComputedStyleMapUnvendor(t *testing.T) {
+	defer govfx.SetStyleProvider(nil)
+
+	for _, name := range []string{"-webkit-transform", "-Webkit-transform"} {
+		govfx.SetStyleProvider(vendoredStyles{name: "rotate(10deg)"})
+
+		styles, err := govfx.GetComputedStyleMap(nil, "")
+		if err != nil {
+			t.Fatalf("Expected the computed styles of the provider: %s", err)
+		}
+
+		cs, err := styles.Get("transform")
+		if err != nil || cs.Value != "rotate(10deg)" || cs.VendorName != name {
+			t.Errorf("Expected %q to be found as the transform but got %+v with %v", name, cs, err)
+		}
+
+		if val, _, ok := govfx.NewElement(nil, "").Read("transform", ""); !ok || val != "rotate(10deg)" {
+			t.Errorf("Expected the element to read %q as its transform but got %q", name, val)
+		}
+	}
+}
+
+// TestParseUnit validates the behaviour of the ParseUnit function.
+func TestParseUnit(t *testing.T) {
+	units := map[string]struct {
+		magnitude float64
+		unit      string
+	}{
+		"42.5px": {42.5, "px"},
+		"-10%":   {-10, "%"},
+		"+.5em":  {0.5, "em"},
+		"100vh":  {100, "vh"},
+		"3":      {3, ""},
+	}
+
+	for value, expected := range units {
+		magnitude, unit, err := govfx.ParseUnit(value)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got error: %s", value, err)
+		}
+
+		if magnitude != expected.magnitude || unit != expected.unit {
+			t.Errorf("Expected %q to parse as (%v, %q) but got (%v, %q)", value, expected.magnitude, expected.unit, magnitude, unit)
+		}
+
+		if formatted := govfx.FormatUnit(magnitude, unit); formatted != govfx.FormatUnit(expected.magnitude, expected.unit) {
+			t.Errorf("Expected %q to round-trip but got %q", value, formatted)
+		}
+	}
+
+	for _, value := range []string{"", "abc", "10qq", "10px 20px"} {
+		if _, _, err := govfx.ParseUnit(value); err != govfx.ErrInvalidUnit {
+			t.Errorf("Expected %q to return ErrInvalidUnit but got %v", value, err)
+		}
+	}
+}
+
+// TestComputedStyleMapAddMore validates the merging of function list values.
+func TestComputedStyleMapAddMore(t *testing.T) {
+	styles := make(govfx.ComputedStyleMap)
+	styles.AddCSSText("transform: translateX(10px); width: 10px;")
+	styles.AddCSSText("transform: rotate(45deg);")
+	styles.AddMore("transform", "translateX(20px)", false)
+	styles.Add("width", "20px", false)
+
+	expected := "transform: translateX(20px) rotate(45deg); width: 20px;"
+	if css := styles.String(); css != expected {
+		t.Fatalf("Expected %q but got %q", expected, css)
+	}
+}
+
+// TestComputedStyleMapAddCSSText validates the splitting of declarations,
+// which keeps the semicolons within parentheses and quotes.
+func TestComputedStyleMapAddCSSText(t *testing.T) {
+	styles := make(govfx.ComputedStyleMap)
+	styles.AddCSSText(`background-image: url(data:image/png;base64,AAAA); content: "a;b"; opacity: 1;`)
+
+	expected := `background-image: url(data:image/png;base64,AAAA); content: "a;b"; opacity: 1;`
+	if css := styles.String(); css != expected {
+		t.Fatalf("Expected %q but got %q", expected, css)
+	}
+}
+
+// TestSplitTopLevel validates the behaviour of the SplitTopLevel function,
+// which keeps functions and quotes whole.
+func TestSplitTopLevel(t *testing.T) {
+	splits := map[string][]string{
+		"200px  minmax(100px, 1fr)\tauto": {"200px", "minmax(100px, 1fr)", "auto"},
+		" calc(1px + 2px) ":               {"calc(1px + 2px)"},
+		`"a b" url('c d')`:                {`"a b"`, `url('c d')`},
+	}
+
+	for value, expected := range splits {
+		if parts := govfx.SplitTopLevel(value, ' '); !reflect.DeepEqual(parts, expected) {
+			t.Errorf("Expected %q to split into %q but got %q", value, expected, parts)
+		}
+	}
+
+	if parts := govfx.SplitTopLevel("rgba(0, 0, 0, 0.5) 1px, red 2px", ','); !reflect.DeepEqual(parts, []string{"rgba(0, 0, 0, 0.5) 1px", " red 2px"}) {
+		t.Errorf("Expected the shadows to split by their commas but got %q", parts)
+	}
+}
+
+// TestComputedStyleMapAddVendored validates the behaviour of merging vendor
+// prefixed properties, regardless of the order they are added in.
+func TestComputedStyleMapAddVendored(t *testing.T) {
+	decls := [][2]string{
+		{"-webkit-transform", "rotate(10deg)"},
+		{"transform", "rotate(20deg)"},
+		{"-moz-transform", "rotate(30deg)"},
+	}
+
+	for _, order := range [][]int{{0, 1, 2}, {2, 1, 0}, {1, 0, 2}, {2, 0, 1}} {
+		styles := make(govfx.ComputedStyleMap)
+
+		for _, index := range order {
+			styles.AddVendored(decls[index][0], decls[index][1], false)
+		}
+
+		cs, err := styles.Get("transform")
+		if err != nil {
+			t.Fatalf("Expected the transform to be merged: %s", err)
+		}
+
+		if cs.Value != "rotate(20deg)" || cs.VendorName != "transform" {
+			t.Fatalf("Expected the unprefixed transform for %v but got %q from %q", order, cs.Value, cs.VendorName)
+		}
+
+		if names := strings.Join(cs.VendorNames, " "); names != "-moz-transform -webkit-transform transform" {
+			t.Fatalf("Expected all forms of the transform to be recorded but got %q", names)
+		}
+	}
+
+	styles := make(govfx.ComputedStyleMap)
+	styles.AddVendored("-webkit-filter", "blur(2px)", false)
+	styles.AddVendored("-moz-filter", "blur(4px)", false)
+
+	if cs, _ := styles.Get("filter"); cs == nil || cs.VendorName != "-moz-filter" {
+		t.Fatalf("Expected the first prefixed form by name without an unprefixed form but got %+v", cs)
+	}
+}
+
+// TestEvalCalc validates the behaviour of evaluating calc expressions.
+func TestEvalCalc(t *testing.T) {
+	exprs := map[string]float64{
+		"calc(100% - 20px)":             180,
+		"calc((100% - 20px) / 2)":       90,
+		"calc(2 * 10px + 5px * 3)":      35,
+		"calc(10px + calc(50% * 2))":    210,
+		"calc(-10px + 2 * (3px - 1px))": -6,
+	}
+
+	for expr, expected := range exprs {
+		if val, err := govfx.EvalCalc(expr, 200); err != nil || val != expected {
+			t.Errorf("Expected %q to evaluate to %g but got %g: %v", expr, expected, val, err)
+		}
+	}
+
+	failures := map[string]error{
+		"calc(1em + 2px)":   govfx.ErrCalcUnits,
+		"calc(10px * 10px)": govfx.ErrCalcUnits,
+		"calc(10px + 2)":    govfx.ErrCalcUnits,
+		"calc(10px":         govfx.ErrInvalidCalc,
+	}
+
+	for expr, expected := range failures {
+		if _, err := govfx.EvalCalc(expr, 200); err != expected {
+			t.Errorf("Expected %q to fail with %v but got %v", expr, expected, err)
+		}
+	}
+}
+
+// TestHexToRGBA validates the behaviour of the HexToRGBA and HexToRGBAf
+// functions, which clamp their alpha.
+func TestHexToRGBA(t *testing.T) {
+	percents := map[int]string{
+		50:  "rgba(255,0,0,0.5)",
+		100: "rgba(255,0,0,1)",
+		150: "rgba(255,0,0,1)",
+		-20: "rgba(255,0,0,0)",
+	}
+
+	for alpha, expected := range percents {
+		if color := govfx.HexToRGBA("#ff0000", alpha); color != expected {
+			t.Errorf("Expected HexToRGBA(%d) to be %q but got %q", alpha, expected, color)
+		}
+	}
+
+	floats := map[float64]string{
+		0.25:   "rgba(255,0,0,0.25)",
+		0.3333: "rgba(255,0,0,0.333)",
+		1.5:    "rgba(255,0,0,1)",
+		-1:     "rgba(255,0,0,0)",
+	}
+
+	for alpha, expected := range floats {
+		if color := govfx.HexToRGBAf("#ff0000", alpha); color != expected {
+			t.Errorf("Expected HexToRGBAf(%g) to be %q but got %q", alpha, expected, color)
+		}
+	}
+
+	if color := govfx.HexToRGBAf("#ff000080", 1); color != "rgba(255,0,0,0.502)" {
+		t.Errorf("Expected the alpha of the hex color to be used but got %q", color)
+	}
+}
+
+// TestToRGBA validates the behaviour of the ToRGBA function, which returns
+// zeros for invalid colors.
+func TestToRGBA(t *testing.T) {
+	colors := map[string][4]int{
+		"#f00":      {255, 0, 0, 255},
+		"#f008":     {255, 0, 0, 136},
+		"ff0000":    {255, 0, 0, 255},
+		"#ff000080": {255, 0, 0, 128},
+		"#f":        {0, 0, 0, 0},
+		"":          {0, 0, 0, 0},
+		"#ff00zz":   {0, 0, 0, 0},
+	}
+
+	for hex, expected := range colors {
+		if r, g, b, a := govfx.ToRGBA(hex); [4]int{r, g, b, a} != expected {
+			t.Errorf("Expected ToRGBA(%q) to be %v but got %v", hex, expected, [4]int{r, g, b, a})
+		}
+	}
+}
+
+// TestToHex validates the behaviour of the ToHex and ToHexA functions, which
+// only accept rgb/rgba colors.
+func TestToHex(t *testing.T) {
+	colors := map[string]string{
+		"rgb(255, 0, 0)":        "#ff0000ff",
+		" rgba(0,128,255,0.5) ": "#0080ff80",
+	}
+
+	for rgb, expected := range colors {
+		if hex, err := govfx.ToHexA(rgb); err != nil || hex != expected {
+			t.Errorf("Expected ToHexA(%q) to be %q but got %q with %v", rgb, expected, hex, err)
+		}
+	}
+
+	for _, rgb := range []string{"grb(1,2,3)", "b(1,2,3)", "argb(1,2,3)", "rgb(1,2)"} {
+		if _, err := govfx.ToHex(rgb); err != govfx.ErrNotFound {
+			t.Errorf("Expected ToHex(%q) to fail but got %v", rgb, err)
+		}
+	}
+}
+
+// TestLerpValue validates the behaviour of interpolating css values, which
+// switch at the halfway point between values of differing units.
+func TestLerpValue(t *testing.T) {
+	values := []struct {
+		from, to string
+		t        float64
+		expected string
+	}{
+		{"0px", "10px", 0.5, "5.00px"},
+		{"0", "10px", 0.5, "5.00px"},
+		{"2em", "4", 0.5, "3.00em"},
+		{"1em", "10px", 0.25, "1em"},
+		{"1em", "10px", 0.5, "10px"},
+		{"auto", "10px", 0.75, "10px"},
+	}
+
+	for _, value := range values {
+		if lerped := govfx.LerpValue(value.from, value.to, value.t); lerped != value.expected {
+			t.Errorf("Expected LerpValue(%q, %q, %.2f) to be %q but got %q", value.from, value.to, value.t, value.expected, lerped)
+		}
+	}
+}
+
+// TestLerpColor validates the behaviour of interpolating colors, whose alpha
+// is formatted as by HexToRGBA.
+func TestLerpColor(t *testing.T) {
+	colors := map[float64]string{
+		0:     "rgba(0,0,0,0)",
+		0.333: "rgba(85,85,85,0.333)",
+		0.5:   "rgba(128,128,128,0.5)",
+		1:     "rgba(255,255,255,1)",
+	}
+
+	for at, expected := range colors {
+		if color := govfx.LerpColor("rgba(0,0,0,0)", "#ffffff", at); color != expected {
+			t.Errorf("Expected LerpColor at %.3f to be %q but got %q", at, expected, color)
+		}
+
+		if color := govfx.LerpColor("rgba(0,0,0,0)", "#ffffff", at, govfx.HSLMode); !strings.HasSuffix(color, expected[strings.LastIndex(expected, ","):]) {
+			t.Errorf("Expected LerpColor at %.3f in HSLMode to have the alpha of %q but got %q", at, expected, color)
+		}
+	}
+}
+
+// TestDecomposeMatrix validates the behaviour of decomposing matrices and
+// composing them back.
+func TestDecomposeMatrix(t *testing.T) {
+	tx, ty, rotate, sx, sy, skew, err := govfx.DecomposeMatrix("matrix(0, 2, -1, 0, 10, 20)")
+	if err != nil {
+		t.Fatalf("Expected the matrix to decompose: %s", err)
+	}
+
+	for _, value := range [][2]float64{{tx, 10}, {ty, 20}, {rotate, 90}, {sx, 2}, {sy, 1}, {skew, 0}} {
+		if math.Abs(value[0]-value[1]) > 1e-9 {
+			t.Fatalf("Expected %f but got %f within %v", value[1], value[0], []float64{tx, ty, rotate, sx, sy, skew})
+		}
+	}
+
+	matrix := govfx.ComposeMatrix(5, -5, 30, 1.5, 0.5, 20)
+
+	tx, ty, rotate, sx, sy, skew, err = govfx.DecomposeMatrix(matrix)
+	if err != nil {
+		t.Fatalf("Expected the composed matrix to decompose: %s", err)
+	}
+
+	for _, value := range [][2]float64{{tx, 5}, {ty, -5}, {rotate, 30}, {sx, 1.5}, {sy, 0.5}, {skew, 20}} {
+		if math.Abs(value[0]-value[1]) > 1e-4 {
+			t.Fatalf("Expected %f but got %f for %s", value[1], value[0], matrix)
+		}
+	}
+
+	if _, _, _, _, _, _, err := govfx.DecomposeMatrix("rotate(10deg)"); err == nil {
+		t.Fatalf("Expected an error for a non-matrix value")
+	}
+}
+
+// TestExpandShorthand validates the behaviour of expanding box shorthand
+// properties into their longhands.
+func TestExpandShorthand(t *testing.T) {
+	values := map[string][4]string{
+		"10px":                   {"10px", "10px", "10px", "10px"},
+		"10px 20px":              {"10px", "20px", "10px", "20px"},
+		"10px 20px 30px":         {"10px", "20px", "30px", "20px"},
+		"10px 20px 30px 40px":    {"10px", "20px", "30px", "40px"},
+		"calc(1px + 2px) 0 auto": {"calc(1px + 2px)", "0", "auto", "0"},
+	}
+
+	for value, expected := range values {
+		sides := govfx.ExpandShorthand("margin", value)
+
+		for index, side := range []string{"margin-top", "margin-right", "margin-bottom", "margin-left"} {
+			if sides[side] != expected[index] {
+				t.Errorf("Expected %s of margin: %s to be %q but got %q", side, value, expected[index], sides[side])
+			}
+		}
+	}
+
+	radius := govfx.ExpandShorthand("border-radius", "10px 20px / 5px")
+	if radius["border-top-right-radius"] != "20px 5px" {
+		t.Errorf("Expected the elliptical corner to be %q but got %q", "20px 5px", radius["border-top-right-radius"])
+	}
+
+	if sides := govfx.ExpandShorthand("margin", "1px 2px 3px 4px 5px"); sides != nil {
+		t.Errorf("Expected too many values to expand to nil but got %v", sides)
+	}
+
+	styles := make(govfx.ComputedStyleMap)
+	styles.AddCSSText("padding: 4px 8px;")
+
+	if cs, err := styles.Get("padding-left"); err != nil || cs.Value != "8px" {
+		t.Errorf("Expected padding-left to be expanded from padding but got %v, %v", cs, err)
+	}
+}
+
+// TestCollapseShorthand validates the behaviour of collapsing longhands into
+// their box shorthand property.
+func TestCollapseShorthand(t *testing.T) {
+	values := []string{"10px", "10px 20px", "10px 20px 30px", "10px 20px 30px 40px", "1em 2px"}
+
+	for _, value := range values {
+		collapsed, ok := govfx.CollapseShorthand("margin", govfx.ExpandShorthand("margin", value))
+		if !ok || collapsed != value {
+			t.Errorf("Expected margin: %s to collapse back but got %q, %t", value, collapsed, ok)
+		}
+	}
+
+	radius, ok := govfx.CollapseShorthand("border-radius", govfx.ExpandShorthand("border-radius", "10px 20px / 5px"))
+	if !ok || radius != "10px 20px / 5px" {
+		t.Errorf("Expected the elliptical radius to collapse back but got %q, %t", radius, ok)
+	}
+
+	if _, ok := govfx.CollapseShorthand("padding", map[string]string{"padding-top": "1px"}); ok {
+		t.Errorf("Expected missing sides to not collapse")
+	}
+
+	if _, ok := govfx.CollapseShorthand("padding", map[string]string{
+		"padding-top":    "1px !important",
+		"padding-right":  "1px",
+		"padding-bottom": "1px",
+		"padding-left":   "1px",
+	}); ok {
+		t.Errorf("Expected partially important sides to not collapse")
+	}
+}
+
+// BenchmarkComputedStyleFrame benchmarks reading many properties of a element
+// every frame of a running animation, where the computed styles of the
+// element are retrieved once per frame. It requires a browser to run.
+func BenchmarkComputedStyleFrame(b *testing.B) {
+	if js.Global == nil || js.Global.Get("document") == js.Undefined {
+		b.Skip("Requires a browser")
+	}
+
+	var g gear
+	govfx.Init(g.Loop)
+
+	elem := govfx.Document().CreateElement("div")
+	govfx.Document().QuerySelector("body").AppendChild(elem)
+	defer elem.ParentNode().RemoveChild(elem)
+
+	props := []string{
+		"width", "height", "top", "left", "opacity", "color",
+		"margin-top", "margin-left", "padding-top", "padding-left",
+		"font-size", "line-height", "border-top-width", "transform",
+	}
+
+	timeline := govfx.Animate(govfx.Stat{
+		Duration: time.Hour,
+		Progress: govfx.NewListener(func(float64) {
+			for _, prop := range props {
+				govfx.GetComputedStyleValue(elem, "", prop)
+			}
+		}),
+	}, nil, nil)
+
+	timeline.Start()
+	defer timeline.Stop()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		g.Step()
+	}
+}
+
+// TestRelativeValue validates the behaviour of resolving relative css values
+// against their start.
+func TestRelativeValue(t *testing.T) {
+	targets := map[string]string{
+		"+=20px": "30px",
+		"-=4":    "6px",
+		"*=1.5":  "15px",
+		"40px":   "40px",
+		"+=2em":  "10px",
+	}
+
+	for target, expected := range targets {
+		if value := govfx.RelativeValue("10px", target); value != expected {
+			t.Errorf("Expected %q from 10px to resolve to %q but got %q", target, expected, value)
+		}
+	}
+}
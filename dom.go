@@ -15,6 +15,10 @@ import (
 var window dom.Window
 var doc dom.Document
 
+// injected is true while a window set by SetDefaultWindow replaces the global
+// window.
+var injected bool
+
 // Root returns the global js.Object for the current js context.
 func Root() *js.Object {
 	return js.Global
@@ -28,6 +32,15 @@ func Window() dom.Window {
 	return window
 }
 
+// SetDefaultWindow sets the window used in place of the global window, whose
+// document is then queried by the selector functions. Passing nil restores
+// the global window.
+func SetDefaultWindow(w dom.Window) {
+	window = w
+	doc = nil
+	injected = w != nil
+}
+
 // Document returns the current document attached to the window
 func Document() dom.Document {
 	if doc == nil {
@@ -36,12 +49,45 @@ func Document() dom.Document {
 	return doc
 }
 
+// windowOf returns the window of the document owning the element, which is
+// the window of its iframe for elements within an iframe, else returning the
+// default window. A window set by SetDefaultWindow is always preferred.
+func windowOf(elem dom.Element) dom.Window {
+	if injected {
+		return window
+	}
+
+	owner := elem.Underlying().Get("ownerDocument")
+	if owner == nil || owner == js.Undefined || owner.Get("defaultView") == nil {
+		return Window()
+	}
+
+	if html, ok := dom.WrapDocument(owner).(dom.HTMLDocument); ok {
+		return html.DefaultView()
+	}
+
+	return Window()
+}
+
+// Queryable defines a root whose tree can be queried by a selector, which
+// dom.Document, dom.Element and dom.DocumentFragment match, allowing queries
+// to be scoped to the document of an iframe or to a subtree.
+type Queryable interface {
+	QuerySelectorAll(string) []dom.Element
+}
+
 // QuerySelectorAll returns a lists of elementals that maches the selector
 // provided else returns an empty lists.
 func QuerySelectorAll(selector string) Elementals {
+	return QuerySelectorAllIn(Document(), selector)
+}
+
+// QuerySelectorAllIn returns a lists of elementals within the root that
+// matches the selector provided else returns an empty lists.
+func QuerySelectorAllIn(root Queryable, selector string) Elementals {
 	var eml Elementals
 
-	items := Document().QuerySelectorAll(selector)
+	items := root.QuerySelectorAll(selector)
 
 	for _, item := range items {
 		eml = append(eml, NewElement(item, ""))
@@ -133,6 +179,19 @@ func GetShadowRoot(elem dom.Node) (dom.DocumentFragment, bool) {
 	return dom.WrapDocumentFragment(root), true
 }
 
+// QueryShadow returns a lists of elementals within the shadowRoot of the host
+// that matches the selector provided else returns an empty lists. Closed
+// shadowRoots are not exposed by the browser, hence their hosts return an
+// empty lists as hosts without a shadowRoot do.
+func QueryShadow(host dom.Node, selector string) Elementals {
+	root, ok := GetShadowRoot(host)
+	if !ok {
+		return nil
+	}
+
+	return QuerySelectorAllIn(root, selector)
+}
+
 //==============================================================================
 
 // topScrollAttr defines the apppropriate property to retrieve the top scroll
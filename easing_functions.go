@@ -1,5 +1,7 @@
 package govfx
 
+import "math"
+
 //==============================================================================
 
 // EaseIn provides a struct for 'easing-in' based animation.
@@ -21,3 +23,118 @@ func (e EaseInQuad) Ease(ms float64) float64 {
 }
 
 //==============================================================================
+
+// PennerEasings defines the bounce, elastic and back easing functions of the
+// Robert Penner easing equations, keyed by their easing names.
+var PennerEasings = map[string]func(float64) float64{
+	"ease-in-bounce":      EaseInBounce,
+	"ease-out-bounce":     EaseOutBounce,
+	"ease-in-out-bounce":  EaseInOutBounce,
+	"ease-in-elastic":     EaseInElastic,
+	"ease-out-elastic":    EaseOutElastic,
+	"ease-in-out-elastic": EaseInOutElastic,
+	"ease-in-back":        EaseInBack,
+	"ease-out-back":       EaseOutBack,
+	"ease-in-out-back":    EaseInOutBack,
+}
+
+//==============================================================================
+
+// EaseInBounce returns the bounce easing which bounces away from the start.
+func EaseInBounce(t float64) float64 {
+	return 1 - EaseOutBounce(1-t)
+}
+
+// EaseOutBounce returns the bounce easing which bounces to a rest at the end.
+func EaseOutBounce(t float64) float64 {
+	const n1, d1 = 7.5625, 2.75
+
+	switch {
+	case t < 1/d1:
+		return n1 * t * t
+	case t < 2/d1:
+		t -= 1.5 / d1
+		return n1*t*t + 0.75
+	case t < 2.5/d1:
+		t -= 2.25 / d1
+		return n1*t*t + 0.9375
+	}
+
+	t -= 2.625 / d1
+	return n1*t*t + 0.984375
+}
+
+// EaseInOutBounce returns the bounce easing which bounces at both ends.
+func EaseInOutBounce(t float64) float64 {
+	if t < 0.5 {
+		return (1 - EaseOutBounce(1-2*t)) / 2
+	}
+
+	return (1 + EaseOutBounce(2*t-1)) / 2
+}
+
+//==============================================================================
+
+// EaseInElastic returns the elastic easing which winds up from the start.
+func EaseInElastic(t float64) float64 {
+	if t <= 0 || t >= 1 {
+		return math.Max(0, math.Min(1, t))
+	}
+
+	return -math.Pow(2, 10*t-10) * math.Sin((10*t-10.75)*(2*math.Pi/3))
+}
+
+// EaseOutElastic returns the elastic easing which springs past the end before
+// settling.
+func EaseOutElastic(t float64) float64 {
+	if t <= 0 || t >= 1 {
+		return math.Max(0, math.Min(1, t))
+	}
+
+	return math.Pow(2, -10*t)*math.Sin((10*t-0.75)*(2*math.Pi/3)) + 1
+}
+
+// EaseInOutElastic returns the elastic easing which winds up at the start and
+// springs past the end.
+func EaseInOutElastic(t float64) float64 {
+	if t <= 0 || t >= 1 {
+		return math.Max(0, math.Min(1, t))
+	}
+
+	if t < 0.5 {
+		return -(math.Pow(2, 20*t-10) * math.Sin((20*t-11.125)*(2*math.Pi/4.5))) / 2
+	}
+
+	return (math.Pow(2, -20*t+10)*math.Sin((20*t-11.125)*(2*math.Pi/4.5)))/2 + 1
+}
+
+//==============================================================================
+
+// backOvershoot defines the amount by which the back easings overshoot.
+const backOvershoot = 1.70158
+
+// EaseInBack returns the back easing which pulls back before moving forward.
+func EaseInBack(t float64) float64 {
+	return (backOvershoot+1)*t*t*t - backOvershoot*t*t
+}
+
+// EaseOutBack returns the back easing which overshoots the end before
+// settling.
+func EaseOutBack(t float64) float64 {
+	t--
+	return 1 + (backOvershoot+1)*t*t*t + backOvershoot*t*t
+}
+
+// EaseInOutBack returns the back easing which pulls back at the start and
+// overshoots the end.
+func EaseInOutBack(t float64) float64 {
+	const c2 = backOvershoot * 1.525
+
+	if t < 0.5 {
+		return (math.Pow(2*t, 2) * ((c2+1)*2*t - c2)) / 2
+	}
+
+	return (math.Pow(2*t-2, 2)*((c2+1)*(t*2-2)+c2) + 2) / 2
+}
+
+//==============================================================================
@@ -1,8 +1,15 @@
 package govfx
 
 import (
+	"errors"
+	"math"
+	"regexp"
+	"strconv"
 	"strings"
 	"sync"
+
+	"github.com/fatih/camelcase"
+	"github.com/gopherjs/gopherjs/js"
 )
 
 // DefaultEasing defines the default easing key when a invalid easing name is
@@ -79,10 +86,32 @@ var EasingValues = map[string][]float64{
 
 //==============================================================================
 
+// ErrEasingExists is returned when registering a easing under a name already
+// taken by another easing.
+var ErrEasingExists = errors.New("Easing already exists")
+
 // RegisterEasing adds a easing provider into the registery with the specified
-// name, we allow replacing a easing provider for a keyed name, if you so wish.
-func RegisterEasing(name string, easing Easing) {
+// name. Replacing the easing provider for a already registered name returns
+// ErrEasingExists, unless the overwrite flag is set.
+func RegisterEasing(name string, easing Easing, overwrite ...bool) error {
+	if easingProviders.Get(name) != nil && (len(overwrite) == 0 || !overwrite[0]) {
+		return ErrEasingExists
+	}
+
 	easingProviders.Add(name, easing)
+	return nil
+}
+
+// RegisterEasingFunc adds the easing function into the registery with the
+// specified name, following the rules of RegisterEasing.
+func RegisterEasingFunc(name string, fn func(t float64) float64, overwrite ...bool) error {
+	return RegisterEasing(name, EasingFunc(fn), overwrite...)
+}
+
+// CubicBezier returns a easing function for the cubic bezier curve defined
+// by the giving control points, matching the css cubic-bezier() function.
+func CubicBezier(p1x, p1y, p2x, p2y float64) func(float64) float64 {
+	return NewSpline(p1x, p1y, p2x, p2y).Ease
 }
 
 // GetEasingProvider returns the central easing provider for vfx.
@@ -92,16 +121,229 @@ func GetEasingProvider() EasingProviders {
 
 // GetEasing returns the easing function matching the specific easing function
 // name if it exists else it returns the default easing provider set by
-// DefaultEasing constant.
+// DefaultEasing constant. The name is resolved by ParseEasing.
 func GetEasing(easing string) Easing {
-	es := easingProviders.Get(easing)
-	if es == nil {
+	es, err := ParseEasing(easing)
+	if err != nil {
 		es = easingProviders.Get(DefaultEasing)
 	}
 
 	return es
 }
 
+// ErrEasingNotFound is returned when a easing name matches no registered
+// easing.
+var ErrEasingNotFound = errors.New("Easing not found")
+
+// ErrInvalidEasing is returned when a easing function is malformed or its
+// parameters are out of range.
+var ErrInvalidEasing = errors.New("Invalid easing")
+
+// ErrEasingVariable is returned when a easing reads a css variable which is
+// not set, eg var(--ease-bounce), without a fallback.
+var ErrEasingVariable = errors.New("Easing variable not set")
+
+// easingVarMatch defines a matcher for the format eg var(--ease, ease-out).
+var easingVarMatch = regexp.MustCompile("^var\\(\\s*(--[\\w-]+)\\s*(?:,\\s*(.*?)\\s*)?\\)$")
+
+// resolveEasingVar returns the value of the css variable read by the easing,
+// eg var(--ease-bounce), from the first of the elements or the document root
+// without elements, falling back to the fallback of the variable when unset.
+// Easings not reading a variable are returned as they are.
+func resolveEasingVar(easing string, elems Elementals) (string, error) {
+	subs := easingVarMatch.FindStringSubmatch(strings.TrimSpace(easing))
+	if subs == nil {
+		return easing, nil
+	}
+
+	var value string
+
+	if len(elems) > 0 {
+		value, _, _ = elems[0].Read(subs[1], "")
+	} else if js.Global != nil {
+		if vs, err := GetComputedStyleValue(Document().DocumentElement(), "", subs[1]); err == nil {
+			value = vs.String()
+		}
+	}
+
+	if value = strings.TrimSpace(value); value != "" {
+		return value, nil
+	}
+
+	if subs[2] != "" {
+		return subs[2], nil
+	}
+
+	return "", ErrEasingVariable
+}
+
+// bezierMatch defines a matcher for the format eg cubic-bezier(0.25,0.1,0.25,1).
+var bezierMatch = regexp.MustCompile("^cubic-bezier\\(([^)]*)\\)$")
+
+// ParseEasing returns the easing for the giving name, resolving it from the
+// registered easings, by their kebab or camel cased names, first before
+// parsing it as a easing function such as cubic-bezier(0.25, 0.1, 0.25, 1) or
+// steps(6, jump-end). An empty name returns the easing set by the
+// DefaultEasing constant. Returns ErrEasingNotFound for unknown names and
+// ErrInvalidEasing for malformed easing functions, eg a cubic-bezier with x
+// control points outside of [0, 1].
+func ParseEasing(easing string) (Easing, error) {
+	easing = strings.TrimSpace(easing)
+
+	if easing == "" {
+		easing = DefaultEasing
+	}
+
+	if es := easingProviders.Get(easing); es != nil {
+		return es, nil
+	}
+
+	// Names may be given in their camel cased form, eg easeOutBounce.
+	if es := easingProviders.Get(strings.Join(camelcase.Split(easing), "-")); es != nil {
+		return es, nil
+	}
+
+	easing = strings.ToLower(easing)
+
+	if subs := bezierMatch.FindStringSubmatch(easing); subs != nil {
+		args := strings.Split(subs[1], ",")
+		if len(args) != 4 {
+			return nil, ErrInvalidEasing
+		}
+
+		var points [4]float64
+
+		for index, arg := range args {
+			point, err := strconv.ParseFloat(strings.TrimSpace(arg), 64)
+			if err != nil {
+				return nil, ErrInvalidEasing
+			}
+
+			points[index] = point
+		}
+
+		if points[0] < 0 || points[0] > 1 || points[2] < 0 || points[2] > 1 {
+			return nil, ErrInvalidEasing
+		}
+
+		return NewSpline(points[0], points[1], points[2], points[3]), nil
+	}
+
+	switch easing {
+	case "step-start":
+		return Steps{Count: 1, Jump: JumpStart}, nil
+	case "step-end":
+		return Steps{Count: 1, Jump: JumpEnd}, nil
+	}
+
+	if subs := stepsMatch.FindStringSubmatch(easing); subs != nil {
+		count, err := strconv.Atoi(subs[1])
+		if err != nil {
+			return nil, ErrInvalidEasing
+		}
+
+		steps := Steps{Count: count, Jump: subs[2]}
+
+		switch steps.Jump {
+		case "", "end":
+			steps.Jump = JumpEnd
+		case "start":
+			steps.Jump = JumpStart
+		case JumpStart, JumpEnd, JumpBoth, JumpNone:
+		default:
+			return nil, ErrInvalidEasing
+		}
+
+		if steps.Count < 1 || (steps.Jump == JumpNone && steps.Count < 2) {
+			return nil, ErrInvalidEasing
+		}
+
+		return steps, nil
+	}
+
+	return nil, ErrEasingNotFound
+}
+
+// SampleEasing returns n outputs of the easing resolved by ParseEasing, taken
+// at evenly spaced times from 0 to 1 inclusive, where a single sample is
+// taken at 0 and no samples for n below 1. Returns the error of ParseEasing
+// for a unknown or malformed easing.
+func SampleEasing(name string, n int) ([]float64, error) {
+	es, err := ParseEasing(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var samples []float64
+
+	for i := 0; i < n; i++ {
+		var t float64
+		if n > 1 {
+			t = float64(i) / float64(n-1)
+		}
+
+		samples = append(samples, es.Ease(t))
+	}
+
+	return samples, nil
+}
+
+//==============================================================================
+
+// stepsMatch defines a matcher for the format eg steps(6, jump-end).
+var stepsMatch = regexp.MustCompile("^steps\\(\\s*(\\d+)\\s*(?:,\\s*([a-z-]+)\\s*)?\\)$")
+
+// Jump terms define where the discontinuities of a Steps easing land.
+const (
+	JumpStart = "jump-start"
+	JumpEnd   = "jump-end"
+	JumpBoth  = "jump-both"
+	JumpNone  = "jump-none"
+)
+
+// Steps provides a easing which jumps in Count discrete increments, matching
+// the css steps() function. Jump sets where the jumps land: jump-start jumps
+// at the start of each step, jump-end at its end, jump-both at both ends of
+// the timeline and jump-none at neither.
+type Steps struct {
+	Count int
+	Jump  string
+}
+
+// Ease returns the staircase value for the giving value.
+func (s Steps) Ease(t float64) float64 {
+	if t <= 0 && s.Jump != JumpStart && s.Jump != JumpBoth {
+		return 0
+	}
+
+	if t >= 1 {
+		return 1
+	}
+
+	step := int(math.Floor(t * float64(s.Count)))
+	jumps := s.Count
+
+	switch s.Jump {
+	case JumpStart:
+		step++
+	case JumpBoth:
+		step++
+		jumps++
+	case JumpNone:
+		jumps--
+	}
+
+	if step < 0 {
+		step = 0
+	}
+
+	if step > jumps {
+		step = jumps
+	}
+
+	return float64(step) / float64(jumps)
+}
+
 //==============================================================================
 
 // Easing defines a interface that returns a new value for the provided values.
@@ -109,6 +351,14 @@ type Easing interface {
 	Ease(float64) float64
 }
 
+// EasingFunc defines a function type which implements the Easing interface.
+type EasingFunc func(float64) float64
+
+// Ease calls the function with the giving value.
+func (e EasingFunc) Ease(t float64) float64 {
+	return e(t)
+}
+
 // EasingProviders provides a interface type to expose easing function providers.
 type EasingProviders interface {
 	Get(string) Easing
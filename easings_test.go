@@ -0,0 +1,174 @@
+package govfx_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/influx6/govfx"
+)
+
+// TestRegisterEasing validates the behaviour of registering custom easings.
+func TestRegisterEasing(t *testing.T) {
+	half := func(t float64) float64 { return t / 2 }
+
+	if err := govfx.RegisterEasingFunc("test-half", half); err != nil {
+		t.Fatalf("Expected easing to register: %s", err)
+	}
+
+	if err := govfx.RegisterEasingFunc("test-half", half); err != govfx.ErrEasingExists {
+		t.Fatalf("Expected ErrEasingExists but got %v", err)
+	}
+
+	if err := govfx.RegisterEasing("test-half", govfx.EasingFunc(half), true); err != nil {
+		t.Fatalf("Expected easing to be overwritten: %s", err)
+	}
+
+	if eased := govfx.GetEasing("test-half").Ease(0.5); eased != 0.25 {
+		t.Fatalf("Expected registered easing to return 0.25 but got %.4f", eased)
+	}
+
+	// cubic-bezier(0.42, 0, 1, 1) is the css ease-in curve.
+	easeIn := govfx.CubicBezier(0.42, 0, 1, 1)
+	if eased := easeIn(0.5); math.Abs(eased-0.3153) > 0.001 {
+		t.Fatalf("Expected ease-in at 0.5 to be 0.3153 but got %.4f", eased)
+	}
+}
+
+// TestParseEasing validates the behaviour of resolving easing names and
+// cubic-bezier functions.
+func TestParseEasing(t *testing.T) {
+	es, err := govfx.ParseEasing("cubic-bezier(0.42, 0, 1, 1)")
+	if err != nil {
+		t.Fatalf("Expected cubic-bezier to parse: %s", err)
+	}
+
+	if eased := es.Ease(0.5); math.Abs(eased-0.3153) > 0.001 {
+		t.Fatalf("Expected cubic-bezier at 0.5 to be 0.3153 but got %.4f", eased)
+	}
+
+	if _, err := govfx.ParseEasing("cubic-bezier(1.5, 0, 1, 1)"); err != govfx.ErrInvalidEasing {
+		t.Fatalf("Expected ErrInvalidEasing for x outside [0, 1] but got %v", err)
+	}
+
+	if _, err := govfx.ParseEasing("wobble"); err != govfx.ErrEasingNotFound {
+		t.Fatalf("Expected ErrEasingNotFound but got %v", err)
+	}
+
+	timeline := govfx.Animate(govfx.Stat{Easing: "cubic-bezier(2, 0, 1, 1)"}, nil, nil)
+	if err := timeline.Start(); err != govfx.ErrInvalidEasing {
+		t.Fatalf("Expected Start to return ErrInvalidEasing but got %v", err)
+	}
+}
+
+// TestStepsEasing validates the behaviour of the steps() easing jump terms.
+func TestStepsEasing(t *testing.T) {
+	samples := map[string][]float64{
+		"steps(4)":             {0, 0.25, 0.5, 1},
+		"steps(4, jump-start)": {0.25, 0.5, 0.75, 1},
+		"steps(4, jump-both)":  {0.2, 0.4, 0.6, 1},
+		"steps(4, jump-none)":  {0, 1.0 / 3, 2.0 / 3, 1},
+	}
+
+	for name, expected := range samples {
+		es, err := govfx.ParseEasing(name)
+		if err != nil {
+			t.Fatalf("Expected %q to parse: %s", name, err)
+		}
+
+		for index, at := range []float64{0, 0.3, 0.5, 1} {
+			if eased := es.Ease(at); math.Abs(eased-expected[index]) > 1e-9 {
+				t.Fatalf("Expected %q at %.1f to be %.4f but got %.4f", name, at, expected[index], eased)
+			}
+		}
+	}
+}
+
+// TestPennerEasings validates the behaviour of the bounce, elastic and back
+// easings against known samples.
+func TestPennerEasings(t *testing.T) {
+	samples := map[string][3]float64{
+		"easeOutBounce":  {0, 0.765625, 1},
+		"easeInElastic":  {0, -0.015625, 1},
+		"easeOutElastic": {0, 1.015625, 1},
+		"easeInOutBack":  {0, 0.5, 1},
+	}
+
+	for name, expected := range samples {
+		es, err := govfx.ParseEasing(name)
+		if err != nil {
+			t.Fatalf("Expected %q to resolve: %s", name, err)
+		}
+
+		for index, at := range []float64{0, 0.5, 1} {
+			if eased := es.Ease(at); math.Abs(eased-expected[index]) > 1e-6 {
+				t.Fatalf("Expected %q at %.1f to be %.6f but got %.6f", name, at, expected[index], eased)
+			}
+		}
+	}
+
+	if eased := govfx.EaseOutBounce(0.25); math.Abs(eased-(1-govfx.EaseInBounce(0.75))) > 1e-9 {
+		t.Fatalf("Expected ease-in-bounce to mirror ease-out-bounce")
+	}
+}
+
+// TestSampleEasing validates the behaviour of sampling easings.
+func TestSampleEasing(t *testing.T) {
+	samples, err := govfx.SampleEasing("steps(2, jump-end)", 5)
+	if err != nil {
+		t.Fatalf("Expected the steps easing to be sampled: %s", err)
+	}
+
+	expected := []float64{0, 0, 0.5, 0.5, 1}
+
+	for index, sample := range samples {
+		if sample != expected[index] {
+			t.Fatalf("Expected samples %v but got %v", expected, samples)
+		}
+	}
+
+	if _, err := govfx.SampleEasing("wobbly", 5); err != govfx.ErrEasingNotFound {
+		t.Fatalf("Expected ErrEasingNotFound for a unknown easing but got %v", err)
+	}
+}
+
+// TestEasingVariable validates the behaviour of stat easings reading a css
+// variable from the first element of the animation.
+func TestEasingVariable(t *testing.T) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	govfx.SetStyleProvider(cannedStyles("--ease-snap: steps(2); width: 0px;"))
+	defer govfx.SetStyleProvider(nil)
+
+	easings := map[string]error{
+		"var(--ease-snap)":          nil,
+		"var(--ease-unset, linear)": nil,
+		"var(--ease-unset)":         govfx.ErrEasingVariable,
+	}
+
+	for easing, expected := range easings {
+		elem := &fakeElem{Elemental: govfx.NewElement(nil, "")}
+
+		timeline := govfx.Animate(govfx.Stat{Duration: time.Hour, Easing: easing}, govfx.Values{{"animate": "width", "value": 100}}, govfx.Elementals{elem})
+
+		if err := timeline.Start(); err != expected {
+			t.Fatalf("Expected starting with the easing %q to return %v but got %v", easing, expected, err)
+		}
+
+		timeline.Stop()
+	}
+
+	elem := &fakeElem{Elemental: govfx.NewElement(nil, "")}
+	govfx.Animate(govfx.Stat{Easing: "var(--ease-snap)"}, govfx.Values{{"animate": "width", "value": 100}}, govfx.Elementals{elem})
+
+	elem.Update(0, 0.6)
+
+	var buf bytes.Buffer
+	elem.CSS(&buf)
+
+	if buf.String() != "width: 50px;" {
+		t.Fatalf("Expected the resolved steps easing to snap to the halfway width but got %q", buf.String())
+	}
+}
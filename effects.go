@@ -0,0 +1,63 @@
+package govfx
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+//==============================================================================
+
+// EffectBuilder defines a function building the sequences of an effect from
+// its options, eg the distance an element slides in from.
+type EffectBuilder func(opts Value) Values
+
+// effects holds the registered effects keyed by their lowercased name.
+var effects = struct {
+	rl sync.RWMutex
+	c  map[string]EffectBuilder
+}{c: make(map[string]EffectBuilder)}
+
+// RegisterEffect adds an effect composing the sequences built by the giving
+// function under the name, eg an enter effect fading an element in while it
+// slides up, replacing any effect already registered with the name. Names
+// are matched regardless of case.
+func RegisterEffect(name string, build EffectBuilder) {
+	effects.rl.Lock()
+	defer effects.rl.Unlock()
+
+	effects.c[strings.ToLower(name)] = build
+}
+
+// Effect returns the sequences of the named effect built with the options,
+// else returns an error if no effect is registered with the name.
+func Effect(name string, opts Value) (Values, error) {
+	effects.rl.RLock()
+	build := effects.c[strings.ToLower(name)]
+	effects.rl.RUnlock()
+
+	if build == nil {
+		return nil, fmt.Errorf("No Effect with Name[%s]", name)
+	}
+
+	if opts == nil {
+		opts = Value{}
+	}
+
+	return build(opts), nil
+}
+
+// QueryEffect uses a selector to retrieve the elements to animate with the
+// named effect built with the options, returning the frame for the animation
+// sequence as QuerySequence does. Returns an error if no effect is
+// registered with the name.
+func QueryEffect(selector string, stat Stat, name string, opts Value) (*SeqBev, error) {
+	vs, err := Effect(name, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return QuerySequence(selector, stat, vs), nil
+}
+
+//==============================================================================
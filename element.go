@@ -1,8 +1,10 @@
 package govfx
 
 import (
+	"bytes"
 	"io"
 	"regexp"
+	"sort"
 	"strings"
 
 	"honnef.co/go/js/dom"
@@ -38,6 +40,14 @@ type Elemental interface {
 	CSS(io.Writer)
 }
 
+// Indexable defines a interface for elementals which know their index among
+// the elements animated alongside them, which is set before their sequences
+// are initialized.
+type Indexable interface {
+	Index() int
+	SetIndex(int)
+}
+
 // Elementals defines a lists of elementals,
 type Elementals []Elemental
 
@@ -48,8 +58,16 @@ type Elementals []Elemental
 // inlined styles.
 type Element struct {
 	dom.Element
-	props []Sequence
-	css   ComputedStyleMap // css holds the map of computed styles.
+	props  []Sequence
+	pseudo string
+	index  int
+	pure   bool
+	css    ComputedStyleMap // css holds the map of computed styles.
+
+	// transform holds the functions of the transform of the element from
+	// before its sequences, once read.
+	transform     []string
+	transformRead bool
 }
 
 // NewElement returns an instancee of the Element struct.
@@ -61,12 +79,25 @@ func NewElement(elem dom.Element, pseudo string) Elemental {
 
 	em := Element{
 		css:     css,
+		pseudo:  pseudo,
 		Element: elem,
 	}
 
 	return &em
 }
 
+// Index returns the index of the element among the elements animated
+// alongside it.
+func (e *Element) Index() int {
+	return e.index
+}
+
+// SetIndex sets the index of the element among the elements animated
+// alongside it.
+func (e *Element) SetIndex(index int) {
+	e.index = index
+}
+
 // Add adds the given set of CSSElem objects into the element prop list.
 func (e *Element) Add(css ...Sequence) {
 	e.props = append(e.props, css...)
@@ -79,6 +110,52 @@ func (e *Element) Init() {
 	}
 }
 
+// Err returns the error of the first failable sequence within the elements
+// prop list which failed to initialize.
+func (e *Element) Err() error {
+	for _, prop := range e.props {
+		if fem, ok := prop.(Failable); ok && fem.Err() != nil {
+			return fem.Err()
+		}
+	}
+
+	return nil
+}
+
+// Optimize switches the optimizable sequences within the elements prop list to
+// their transform and opacity based output.
+func (e *Element) Optimize() {
+	for _, prop := range e.props {
+		if oem, ok := prop.(Optimizable); ok {
+			oem.Optimize()
+		}
+	}
+}
+
+// Transitionable returns true/false if all sequences within the elements prop
+// list can be handed to native css transitions.
+func (e *Element) Transitionable() bool {
+	for _, prop := range e.props {
+		if tem, ok := prop.(Transitionable); ok && !tem.Transitionable() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Discrete returns true/false if the elements prop list holds sequences which
+// are all Discrete.
+func (e *Element) Discrete() bool {
+	for _, prop := range e.props {
+		if dem, ok := prop.(Discrete); !ok || !dem.Discrete() {
+			return false
+		}
+	}
+
+	return len(e.props) > 0
+}
+
 // Reset resets the resetable sequences within the elements prop list.
 func (e *Element) Reset() {
 	for _, elem := range e.props {
@@ -100,30 +177,133 @@ func (e *Element) Blend(d float64) {
 // Update calls the internal Update functions of the sequence list.
 func (e *Element) Update(d float64, timeline float64) {
 	for _, prop := range e.props {
+		if e.pure && effectful(prop) {
+			continue
+		}
+
 		prop.Update(d, timeline)
 	}
 }
 
+// UpdateEffects updates only the Effectful sequences within the elements prop
+// list, whose changes are not held by the recorded css of the element.
+func (e *Element) UpdateEffects(d float64, timeline float64) {
+	if e.pure {
+		return
+	}
+
+	for _, prop := range e.props {
+		if effectful(prop) {
+			prop.Update(d, timeline)
+		}
+	}
+}
+
+// Pure stops the Effectful sequences within the elements prop list from being
+// updated, hence updating the element only changes its css.
+func (e *Element) Pure() {
+	e.pure = true
+}
+
+// effectful returns true/false if the sequence is Effectful.
+func effectful(seq Sequence) bool {
+	em, ok := seq.(Effectful)
+	return ok && em.Effectful()
+}
+
 // Clear empties the css sequence list for the element.
 func (e *Element) Clear() {
 	e.props = nil
 }
 
 // CSS collects all the internal css data to be writting and writes it out to the
-// passed writer.
+// passed writer. The output of the sequences are merged, where function list
+// properties like transform combine the functions written by each sequence
+// rather than the last sequence replacing the others. The functions written
+// to the transform are merged into the transform of the element, hence a
+// translation keeps the rotation and scale of the element.
 func (e *Element) CSS(w io.Writer) {
+	styles := make(ComputedStyleMap)
+
+	var buf bytes.Buffer
+
 	for _, elem := range e.props {
-		elem.CSS(w)
+		buf.Reset()
+		elem.CSS(&buf)
+		styles.AddCSSText(buf.String())
 	}
+
+	if cs, ok := styles["transform"]; ok {
+		if base := e.baseTransform(); len(base) > 0 {
+			merged := make(ComputedStyleMap)
+			merged.Add("transform", strings.Join(base, " "), false)
+			merged.AddMore("transform", cs.Value, cs.Priority)
+
+			// Translations lead the transform, hence are not turned by the
+			// rotation of the element.
+			transform := merged["transform"]
+			sort.SliceStable(transform.Values, func(i, j int) bool {
+				return transformRank(transform.Values[i]) < transformRank(transform.Values[j])
+			})
+			transform.Value = strings.Join(transform.Values, " ")
+
+			styles["transform"] = transform
+		}
+	}
+
+	io.WriteString(w, styles.String())
+}
+
+// transformRank returns the rank of the transform function within a merged
+// transform, where perspective leads the translations leading the others.
+func transformRank(fn string) int {
+	switch name := ValueName(fn); {
+	case name == "perspective":
+		return 0
+	case strings.HasPrefix(name, "translate"):
+		return 1
+	}
+
+	return 2
+}
+
+// baseTransform returns the functions of the transform of the element from
+// before its sequences.
+func (e *Element) baseTransform() []string {
+	if !e.transformRead {
+		e.transformRead = true
+
+		if transform, _, ok := e.Read("transform", ""); ok {
+			e.transform = transformFunctions(transform)
+		}
+	}
+
+	return e.transform
 }
 
 var propName = regexp.MustCompile("([\\w\\-0-9]+)\\(?\\)?")
 
+// ValueName returns the function name of a css property value, eg translateX
+// for translateX(10px). Values without a function form like 10px are
+// returned whole, so they can still be used as a comparison key.
+func ValueName(val string) string {
+	subs := propName.FindStringSubmatch(val)
+	if len(subs) < 2 {
+		return strings.TrimSpace(val)
+	}
+
+	return subs[1]
+}
+
 // Read reads out the elements internal css property rule and returns its
 // values list and priority(whether it has !important attached).
 // If the property does not exists a false value is returned.
 func (e *Element) Read(prop string, selector string) (string, bool, bool) {
 	cs, err := e.css.Get(prop)
+	if err != nil && IsCustomProperty(prop) && e.Element != nil {
+		cs, err = e.customProperty(prop)
+	}
+
 	if err != nil {
 		return "", false, false
 	}
@@ -133,8 +313,7 @@ func (e *Element) Read(prop string, selector string) (string, bool, bool) {
 	// boolean return value to indicate a failure.
 	if strings.TrimSpace(selector) != "" {
 		for _, val := range cs.Values {
-			valName := propName.FindStringSubmatch(val)[1]
-			if valName != selector {
+			if ValueName(val) != selector {
 				continue
 			}
 
@@ -148,6 +327,24 @@ func (e *Element) Read(prop string, selector string) (string, bool, bool) {
 	return cs.Value, cs.Priority, true
 }
 
+// customProperty reads the custom property from the computed styles of the
+// element, as custom properties are missing from the listed computed styles.
+// The value read is kept alongside the other styles of the element.
+func (e *Element) customProperty(prop string) (*ComputedStyle, error) {
+	vs, err := GetComputedStyleValue(e.Element, e.pseudo, prop)
+	if err != nil {
+		return nil, err
+	}
+
+	value := strings.TrimSpace(vs.String())
+	if value == "" {
+		return nil, ErrNotFound
+	}
+
+	e.css.Add(prop, value, false)
+	return e.css[prop], nil
+}
+
 // ReadInt reads the given property and attempts to convert its value into a
 // int type else returns 0 as that value type.
 func (e *Element) ReadInt(prop string, sel string) (int, bool, bool) {
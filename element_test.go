@@ -0,0 +1,421 @@
+package govfx_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/influx6/govfx"
+	"github.com/influx6/govfx/animators"
+	"honnef.co/go/js/dom"
+)
+
+// TestValueName validates the behaviour of the ValueName function.
+func TestValueName(t *testing.T) {
+	values := map[string]string{
+		"translateX(10px) rotate(45deg)": "translateX",
+		"rotate(45deg)":                  "rotate",
+		"matrix(1, 0, 0, 1, 0, 0)":       "matrix",
+		"10px":                           "10px",
+		"none":                           "none",
+		"  ":                             "",
+		"":                               "",
+	}
+
+	for value, expected := range values {
+		if name := govfx.ValueName(value); name != expected {
+			t.Errorf("Expected ValueName(%q) to be %q but got %q", value, expected, name)
+		}
+	}
+}
+
+// cannedStyles provides a govfx.StyleProvider returning the same computed
+// styles for every element.
+type cannedStyles string
+
+// ComputedStyle returns the canned styles as a map.
+func (c cannedStyles) ComputedStyle(dom.Element, string) (govfx.ComputedStyleMap, error) {
+	styles := make(govfx.ComputedStyleMap)
+	styles.AddCSSText(string(c))
+	return styles, nil
+}
+
+// TestStyleProvider validates the behaviour of sequences reading the computed
+// styles of their element from a fake StyleProvider.
+func TestStyleProvider(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("opacity: 0.2; margin-left: 10px;"))
+	defer govfx.SetStyleProvider(nil)
+
+	elem := govfx.NewElement(nil, "")
+	elem.Add(
+		&animators.Numeric{Property: "opacity", Target: 1, Easing: "linear"},
+		&animators.Numeric{Property: "margin-left", Target: 30, Easing: "linear"},
+	)
+
+	elem.Init()
+	elem.Update(0, 0.5)
+
+	var buf bytes.Buffer
+	elem.CSS(&buf)
+
+	if expected := "margin-left: 20.00px; opacity: 0.60;"; buf.String() != expected {
+		t.Fatalf("Expected the halfway frame to be %q but got %q", expected, buf.String())
+	}
+}
+
+// TestKeyframedEasing validates the behaviour of keyframes easing each segment
+// by the easing of its starting stop.
+func TestKeyframedEasing(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("margin-left: 0px;"))
+	defer govfx.SetStyleProvider(nil)
+
+	elem := govfx.NewElement(nil, "")
+	elem.Add(animators.NewKeyframed("margin-left", []animators.Keyframe{
+		{Offset: 0, Value: "0px", Easing: "easeOutElastic"},
+		{Offset: 0.5, Value: "100px", Easing: "steps(2)"},
+		{Offset: 1, Value: "200px"},
+	}))
+
+	elem.Init()
+
+	if err := elem.(govfx.Failable).Err(); err != nil {
+		t.Fatalf("Expected the keyframes to initialize but got %q", err)
+	}
+
+	elastic := govfx.GetEasing("easeOutElastic").Ease(0.5)
+
+	frames := map[float64]string{
+		0.25: fmt.Sprintf("margin-left: %.2fpx;", elastic*100),
+		0.6:  "margin-left: 100.00px;",
+		0.8:  "margin-left: 150.00px;",
+	}
+
+	for progress, expected := range frames {
+		elem.Update(0, progress)
+
+		var buf bytes.Buffer
+		elem.CSS(&buf)
+
+		if buf.String() != expected {
+			t.Errorf("Expected the frame at %.2f to be %q but got %q", progress, expected, buf.String())
+		}
+	}
+
+	elem = govfx.NewElement(nil, "")
+	elem.Add(animators.NewKeyframed("margin-left", []animators.Keyframe{
+		{Offset: 0, Value: "0px", Easing: "steps(0)"},
+		{Offset: 1, Value: "100px"},
+	}))
+
+	elem.Init()
+
+	if err := elem.(govfx.Failable).Err(); err != govfx.ErrInvalidEasing {
+		t.Errorf("Expected a malformed stop easing to report %q but got %v", govfx.ErrInvalidEasing, err)
+	}
+}
+
+// TestSpacing validates the behaviour of the letter and word spacing
+// sequences, reading a computed spacing of normal as 0 and converting px to
+// em by the font-size of the element.
+func TestSpacing(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("letter-spacing: normal; word-spacing: 8px; font-size: 16px;"))
+	defer govfx.SetStyleProvider(nil)
+
+	elem := govfx.NewElement(nil, "")
+	elem.Add(
+		&animators.LetterSpacing{Value: 4, Easing: "linear"},
+		&animators.WordSpacing{Value: 1, Unit: "em", Easing: "linear"},
+	)
+
+	elem.Init()
+	elem.Update(0, 0.5)
+
+	var buf bytes.Buffer
+	elem.CSS(&buf)
+
+	if expected := "letter-spacing: 2.00px; word-spacing: 0.75em;"; buf.String() != expected {
+		t.Fatalf("Expected the halfway frame to be %q but got %q", expected, buf.String())
+	}
+}
+
+// TestLineHeight validates the behaviour of the line-height sequence, writing
+// the form of its target while converting its start into that form.
+func TestLineHeight(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("line-height: 24px; font-size: 16px;"))
+	defer govfx.SetStyleProvider(nil)
+
+	heights := map[*animators.LineHeight]string{
+		{Value: "2", Easing: "linear"}:                    "line-height: 1.75;",
+		{Value: "200%", Easing: "linear"}:                 "line-height: 175.00%;",
+		{From: "normal", Value: "32px", Easing: "linear"}: "line-height: 25.60px;",
+	}
+
+	for height, expected := range heights {
+		elem := govfx.NewElement(nil, "")
+		elem.Add(height)
+		elem.Init()
+		elem.Update(0, 0.5)
+
+		var buf bytes.Buffer
+		elem.CSS(&buf)
+
+		if buf.String() != expected {
+			t.Errorf("Expected the halfway frame towards %q to be %q but got %q", height.Value, expected, buf.String())
+		}
+	}
+}
+
+// TestFades validates the behaviour of the fade sequences, which write the
+// display of the elements on the edges of their opacity animations.
+func TestFades(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("display: none; opacity: 1;"))
+	defer govfx.SetStyleProvider(nil)
+
+	frames := []struct {
+		seq      govfx.Sequence
+		timeline float64
+		expected string
+	}{
+		{&animators.FadeIn{Display: "flex", Easing: "linear"}, 0, "display: flex; opacity: 0.00;"},
+		{&animators.FadeIn{Easing: "linear"}, 0.5, "display: block; opacity: 0.50;"},
+		{&animators.FadeOut{Easing: "linear"}, 0.5, "opacity: 0.50;"},
+		{&animators.FadeOut{Easing: "linear"}, 1, "display: none; opacity: 0.00;"},
+	}
+
+	for _, frame := range frames {
+		elem := govfx.NewElement(nil, "")
+		elem.Add(frame.seq)
+		elem.Init()
+		elem.Update(0, frame.timeline)
+
+		var buf bytes.Buffer
+		elem.CSS(&buf)
+
+		if buf.String() != frame.expected {
+			t.Errorf("Expected the frame at %.2f to be %q but got %q", frame.timeline, frame.expected, buf.String())
+		}
+	}
+}
+
+// TestBackdropFilter validates the behaviour of the BackdropFilter sequence,
+// which writes both the prefixed and standard backdrop-filter.
+func TestBackdropFilter(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("backdrop-filter: none;"))
+	defer govfx.SetStyleProvider(nil)
+
+	elem := govfx.NewElement(nil, "")
+	elem.Add(&animators.BackdropFilter{Target: "blur(8px) saturate(2)", Easing: "linear"})
+	elem.Init()
+	elem.Update(0, 0.5)
+
+	var buf bytes.Buffer
+	elem.CSS(&buf)
+
+	expected := "-webkit-backdrop-filter: blur(4.00px) saturate(1.50); backdrop-filter: blur(4.00px) saturate(1.50);"
+	if buf.String() != expected {
+		t.Fatalf("Expected the halfway frame to be %q but got %q", expected, buf.String())
+	}
+}
+
+// TestTranslatePercent validates the behaviour of translations in percent,
+// whose start is converted from the pixels of the element's matrix.
+func TestTranslatePercent(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("transform: matrix(1, 0, 0, 1, 50, 0); width: 200px;"))
+	defer govfx.SetStyleProvider(nil)
+
+	elem := govfx.NewElement(nil, "")
+	elem.Add(&animators.TranslateX{Target: 50, Unit: "%", Easing: "linear"})
+	elem.Init()
+	elem.Update(0, 0.5)
+
+	var buf bytes.Buffer
+	elem.CSS(&buf)
+
+	if expected := "transform: translateX(37.50%);"; buf.String() != expected {
+		t.Fatalf("Expected the halfway frame to be %q but got %q", expected, buf.String())
+	}
+}
+
+// TestGridTemplate validates the behaviour of the grid template sequences,
+// which interpolate each track of a track list in its own unit, where the
+// computed pixel tracks of fr targets start at their share of the free space.
+func TestGridTemplate(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("grid-template-columns: 200px 200px 400px;"))
+	defer govfx.SetStyleProvider(nil)
+
+	elem := govfx.NewElement(nil, "")
+	elem.Add(&animators.GridTemplateColumns{Target: "300px 1fr 1fr", Easing: "linear"})
+	elem.Init()
+	elem.Update(0, 0.5)
+
+	var buf bytes.Buffer
+	elem.CSS(&buf)
+
+	if expected := "grid-template-columns: 250.00px 0.83fr 1.17fr;"; buf.String() != expected {
+		t.Fatalf("Expected the halfway frame to be %q but got %q", expected, buf.String())
+	}
+
+	mismatched := &animators.GridTemplateRows{From: "100px auto", Target: "1fr auto"}
+	mismatched.Init(govfx.NewElement(nil, ""))
+
+	if mismatched.Err() != animators.ErrGridUnits {
+		t.Fatalf("Expected tracks of different units to fail with ErrGridUnits but got %v", mismatched.Err())
+	}
+}
+
+// TestTransformMerge validates the behaviour of the transform sequences,
+// whose functions are merged into the transform of the element.
+func TestTransformMerge(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("transform: matrix(2, 0, 0, 2, 10, 0);"))
+	defer govfx.SetStyleProvider(nil)
+
+	elem := govfx.NewElement(nil, "")
+	elem.Add(&animators.TranslateX{Target: 110, Easing: "linear"})
+	elem.Init()
+	elem.Update(0, 0.5)
+
+	var buf bytes.Buffer
+	elem.CSS(&buf)
+
+	if expected := "transform: translateX(60.00px) scale(2.00, 2.00);"; buf.String() != expected {
+		t.Fatalf("Expected the translation to keep the scale of the element as %q but got %q", expected, buf.String())
+	}
+}
+
+// TestOptimizedPosition validates the behaviour of optimized Numeric sequences
+// of left and top, which keep the translation of the element while moving it
+// by a translate and write the property itself on the final frame.
+func TestOptimizedPosition(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("left: 0px; transform: matrix(1, 0, 0, 1, 5, 0);"))
+	defer govfx.SetStyleProvider(nil)
+
+	elem := govfx.NewElement(nil, "").(*govfx.Element)
+	elem.Add(&animators.Numeric{Property: "left", Target: 100, Unit: "px", Easing: "linear"})
+	elem.Optimize()
+	elem.Init()
+
+	frames := map[float64]string{
+		0.5: "transform: translateX(55.00px);",
+		1:   "left: 100.00px; transform: translateX(5.00px);",
+	}
+
+	for timeline, expected := range frames {
+		elem.Update(0, timeline)
+
+		var buf bytes.Buffer
+		elem.CSS(&buf)
+
+		if buf.String() != expected {
+			t.Errorf("Expected the position at %.1f to be %q but got %q", timeline, expected, buf.String())
+		}
+	}
+}
+
+// TestRotateMerge validates the behaviour of the Rotate sequence, which keeps
+// the translation of the element.
+func TestRotateMerge(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("transform: matrix(1, 0, 0, 1, 10, 20);"))
+	defer govfx.SetStyleProvider(nil)
+
+	elem := govfx.NewElement(nil, "")
+	elem.Add(&animators.Rotate{Target: 90, Easing: "linear"})
+	elem.Init()
+	elem.Update(0, 0.5)
+
+	var buf bytes.Buffer
+	elem.CSS(&buf)
+
+	if expected := "transform: translateX(10.00px) translateY(20.00px) rotate(45.00deg);"; buf.String() != expected {
+		t.Fatalf("Expected the rotation to keep the translation of the element as %q but got %q", expected, buf.String())
+	}
+}
+
+// TestTranslateMerge validates the behaviour of the translate functions of the
+// element, which are split into their axes whichever way their arguments are
+// separated.
+func TestTranslateMerge(t *testing.T) {
+	transforms := map[string]string{
+		"translate(10px, 20px)": "transform: translateX(10px) translateY(20px) rotate(45.00deg);",
+		"translate(10px 20px)":  "transform: translateX(10px) translateY(20px) rotate(45.00deg);",
+		"translate(10px)":       "transform: translateX(10px) rotate(45.00deg);",
+		"translate()":           "transform: rotate(45.00deg);",
+	}
+
+	for transform, expected := range transforms {
+		govfx.SetStyleProvider(cannedStyles("transform: " + transform + ";"))
+
+		elem := govfx.NewElement(nil, "")
+		elem.Add(&animators.Rotate{Target: 90, Easing: "linear"})
+		elem.Init()
+		elem.Update(0, 0.5)
+
+		var buf bytes.Buffer
+		elem.CSS(&buf)
+
+		if buf.String() != expected {
+			t.Errorf("Expected the transform %q to render %q but got %q", transform, expected, buf.String())
+		}
+	}
+
+	govfx.SetStyleProvider(nil)
+}
+
+// TestHeightOverflow validates the behaviour of the Height sequence hiding the
+// overflow of the element, which restores its inline overflow once it ends
+// and leaves the overflow to its stylesheets when it had none.
+func TestHeightOverflow(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("height: 0px; overflow: auto;"))
+	defer govfx.SetStyleProvider(nil)
+
+	inlines := map[string]string{
+		"overflow: scroll;":          "height: 100px;overflow: scroll;",
+		"overflow: clip !important;": "height: 100px;overflow: clip !important;",
+		"":                           "height: 100px;",
+	}
+
+	for inline, expected := range inlines {
+		elem := &fakeElem{Elemental: govfx.NewElement(nil, ""), style: inline}
+
+		height := &animators.Height{Target: 100, Easing: "linear", HideOverflow: true}
+		height.Init(elem)
+
+		var buf bytes.Buffer
+
+		height.Update(0, 0.5)
+		height.CSS(&buf)
+
+		if buf.String() != "height: 50px;overflow: hidden;" {
+			t.Fatalf("Expected the overflow to be hidden while the height animates but got %q", buf.String())
+		}
+
+		buf.Reset()
+		height.Update(0, 1)
+		height.CSS(&buf)
+
+		if buf.String() != expected {
+			t.Errorf("Expected the inline overflow %q to end as %q but got %q", inline, expected, buf.String())
+		}
+	}
+}
+
+// TestScaleMerge validates the behaviour of the Scale sequence combined with
+// a translation, eg the zoomIn effect alongside a slide.
+func TestScaleMerge(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("transform: rotate(30deg);"))
+	defer govfx.SetStyleProvider(nil)
+
+	elem := govfx.NewElement(nil, "")
+	elem.Add(
+		&animators.Scale{From: "0", X: 1, Y: 1, Easing: "linear"},
+		&animators.TranslateY{From: "100", Target: 0, Easing: "linear"},
+	)
+	elem.Init()
+	elem.Update(0, 0.5)
+
+	var buf bytes.Buffer
+	elem.CSS(&buf)
+
+	if expected := "transform: translateY(50.00px) rotate(30deg) scale(0.50);"; buf.String() != expected {
+		t.Fatalf("Expected the scale to keep the translation and rotation as %q but got %q", expected, buf.String())
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"strings"
 
 	"github.com/fatih/camelcase"
+	"github.com/gopherjs/gopherjs/js"
 	"github.com/influx6/faux/loop"
 	"github.com/influx6/faux/loop/web"
+	"honnef.co/go/js/dom"
 )
 
 //==============================================================================
@@ -14,13 +16,109 @@ import (
 // Animate uses writer batching to reduce layout trashing. Hence  each frame
 // assigned for each animation call, will have all their writes batched
 // into one call.
+//
+// The easings of the stat and its sequences are validated by the returned
+// Timeline, whose Start returns an error for a unknown or malformed easing,
+// as it does for the error of any Failable sequence which failed to
+// initialize.
 func Animate(stat Stat, b Values, elems Elementals) *Timeline {
+	easing, easingErr := resolveEasingVar(stat.Easing, elems)
+	stat.Easing = easing
+
 	frame := NewSeqBev(elems, stat, b)
-	return NewTimeline(ModeTimer{
+	timeline := NewTimeline(ModeTimer{
 		Delay:             stat.Delay,
 		MaxMSPerUpdate:    0.01,
 		MaxDeltaPerUpdate: 2.5,
 	}, frame, stat)
+
+	// The staggered elements extend the timeline until the last one ends.
+	timeline.timeline += stat.staggerSpan(len(elems))
+
+	if easingErr != nil {
+		timeline.err = easingErr
+	}
+
+	if timeline.err == nil {
+		timeline.err = validateEasings(b)
+	}
+
+	for _, elem := range elems {
+		if fe, ok := elem.(Failable); ok && timeline.err == nil {
+			timeline.err = fe.Err()
+		}
+	}
+
+	return timeline
+}
+
+// respectReducedMotion sets whether timelines honour the reduced motion
+// preference of the user.
+var respectReducedMotion bool
+
+// RespectReducedMotion sets whether timelines honour the prefers-reduced-motion
+// preference of the user, where timelines started while the user prefers
+// reduced motion jump to their final state, still emitting their begin and
+// end signals. Timelines whose stat sets ForceMotion animate regardless.
+func RespectReducedMotion(respect bool) {
+	respectReducedMotion = respect
+}
+
+// prefersReducedMotion reports the reduced motion preference of the user,
+// being the prefers-reduced-motion media query unless replaced.
+var prefersReducedMotion func() bool
+
+// SetReducedMotionPreference sets the function reporting whether the user
+// prefers reduced motion, eg to test timelines under reduced motion without a
+// browser, where a nil function restores the prefers-reduced-motion media
+// query.
+func SetReducedMotionPreference(prefers func() bool) {
+	prefersReducedMotion = prefers
+}
+
+// ReducedMotion returns true/false if reduced motion is respected and
+// currently preferred by the user.
+func ReducedMotion() bool {
+	if !respectReducedMotion {
+		return false
+	}
+
+	if prefersReducedMotion != nil {
+		return prefersReducedMotion()
+	}
+
+	if js.Global == nil {
+		return false
+	}
+
+	if mm := js.Global.Get("matchMedia"); mm == nil || mm == js.Undefined {
+		return false
+	}
+
+	return js.Global.Call("matchMedia", "(prefers-reduced-motion: reduce)").Get("matches").Bool()
+}
+
+// AnimateDOM animates exactly the provided dom.Elements, which may be detached
+// from the document, sparing the selector query of QuerySequence. See Animate.
+func AnimateDOM(stat Stat, b Values, elems []dom.Element) *Timeline {
+	return Animate(stat, b, TransformElements(elems))
+}
+
+// validateEasings returns an error if any of the values has a easing name
+// which ParseEasing fails to resolve.
+func validateEasings(vals Values) error {
+	for _, val := range vals {
+		easing, ok := val["easing"].(string)
+		if !ok || easing == "" {
+			continue
+		}
+
+		if _, err := ParseEasing(easing); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 //==============================================================================
@@ -33,6 +131,7 @@ var engine loop.GameEngine
 // looper using this.
 func Init(gear loop.EngineGear) {
 	engine = loop.New(gear)
+	scheduler = newFrameScheduler()
 }
 
 // init initializes the selector code before the start of the animators.
@@ -44,6 +143,12 @@ func init() {
 		cased := strings.ToLower(strings.Join(camelcase.Split(name), "-"))
 		RegisterEasing(cased, NewSpline(vals[0], vals[1], vals[2], vals[3]))
 	}
+
+	for name, fn := range PennerEasings {
+		RegisterEasing(name, EasingFunc(fn))
+	}
+
+	RegisterEasing(SpringEasing, DefaultSpring)
 }
 
 //==============================================================================
@@ -24,12 +24,12 @@ func main() {
 
 	elems := govfx.QuerySelectorAll(".zapps")
 	width := govfx.Animate(govfx.Stat{
-		Duration: 1 * time.Second,
-		Loop:     2,
-		Reverse:  true,
-		Begin:    begin,
-		End:      end,
-		Progress: progress,
+		Duration:  1 * time.Second,
+		Loop:      4,
+		Direction: govfx.DirectionAlternate,
+		Begin:     begin,
+		End:       end,
+		Progress:  progress,
 	}, govfx.Values{
 		{"value": 500, "animate": "width", "easing": "ease-in"},
 	}, elems)
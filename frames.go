@@ -2,6 +2,8 @@ package govfx
 
 import (
 	"bytes"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -24,9 +26,10 @@ type Block struct {
 	Buf  *bytes.Buffer
 }
 
-// Do writes the giving buffer into the style attribute of the element.
+// Do writes the giving buffer into the style attribute of the element, which
+// is deferred till the end of the frame when done within the animation loop.
 func (b *Block) Do() {
-	b.Elem.SetAttribute("style", b.Buf.String())
+	scheduler.Write(*b)
 }
 
 // BlockMoment represents a full moment or rendering of the state of a element
@@ -47,11 +50,263 @@ func (b BlockMoment) Run() {
 type Stat struct {
 	Duration time.Duration
 	Delay    time.Duration
-	Loop     int
-	Reverse  bool
-	Begin    Listener
-	End      Listener
+
+	// Easing sets the easing used by the sequences of the animation which do
+	// not set their own easing, eg a linear width alongside an opacity easing
+	// out, where each sequence resolves its easing once when the animation is
+	// created. The spring easing ignores Duration, running the animation
+	// until its Spring, or DefaultSpring when unset, comes to rest. A css
+	// variable, eg var(--ease-bounce), is resolved by Animate from the first
+	// element of the animation, or the document root without elements, where
+	// an unset variable without a fallback fails the start of the timeline
+	// with ErrEasingVariable.
+	Easing string
+	Spring *Spring
+
+	// Speed sets the playback rate of the animation, eg 0.5 plays at half
+	// speed and 2 at double, making the effective duration Duration/Speed. A
+	// zero Speed plays at the normal rate; use the Timeline's SetSpeed to
+	// freeze it.
+	Speed float64
+
+	// MaxFPS caps the rate at which the animation renders its frames and
+	// emits its progress, skipping the frames in between, while its timing
+	// still follows the real elapsed time. A zero MaxFPS renders every frame
+	// of the engine loop.
+	MaxFPS int
+
+	// Loop sets the total iterations of the animation, where a negative value
+	// repeats the animation until it is stopped. End is not fired for such an
+	// infinite animation.
+	Loop int
+
+	// LoopDelay sets the pause between the end of an iteration and the start
+	// of the next, during which no frames are rendered nor progress emitted
+	// and the elements hold the final state of the ended iteration. No pause
+	// follows the final iteration.
+	LoopDelay time.Duration
+
+	// Direction sets the direction each iteration of the animation plays in,
+	// following the css animation-direction values: normal, reverse,
+	// alternate and alternate-reverse. It defaults to normal.
+	Direction string
+
+	// Reverse plays every iteration backward, being an alias of the reverse
+	// Direction which is ignored once a Direction is set.
+	//
+	// Deprecated: Use Direction, where alternate provides the back and forth
+	// across iterations formerly played within each iteration by Reverse.
+	Reverse bool
+
+	// Stagger delays the start of each element of the animation by its index
+	// multiplied with the stagger, on top of Delay, hence the first element
+	// leads unless StaggerReverse is set, where the last element leads.
+	Stagger        time.Duration
+	StaggerReverse bool
+
+	// Optimize reduces the work of the browser for each frame of the
+	// animation:
+	//
+	//  - Sequences which can write their output using transform and opacity
+	//    do so, sparing the browser recalculating the layout of the page.
+	//  - The will-change property of the elements is set to the properties
+	//    the animation writes while it runs, hinting the browser to promote
+	//    them to their own compositor layer. The prior will-change of the
+	//    elements is restored once the animation ends or is cancelled.
+	//  - The styles of an element are only written when they changed since
+	//    their last write, where the writes of an element within a frame
+	//    are always coalesced into a single write.
+	Optimize bool
+
+	// Native hands the animation to the css transitions of the browser, which
+	// interpolate the styles off the engine loop, eg on the compositor. It
+	// sets the transition property of the elements, writes their target
+	// styles and ends the animation once the elements emit transitionend.
+	// Animations which can not be expressed as a single transition run on
+	// the engine loop instead, these being animations whose sequences are
+	// not Transitionable, eg keyframes and counters, set their own easing,
+	// loop, play in reverse, or ease by a function other than a
+	// cubic-bezier or steps, eg a spring. Native animations can not be
+	// paused.
+	Native bool
+
+	// DryRun runs the animation without writing the styles of its elements,
+	// which are still read for the start of its sequences, eg to compute the
+	// values of the animation with Written and Seek. Effectful sequences
+	// changing an element other than by its styles, eg ScrollTo and Counter,
+	// are not updated, hence a dry run leaves the dom untouched. Dry runs
+	// neither replace other animations of the same properties nor are listed
+	// by ActiveAnimations, and are never Native.
+	DryRun bool
+
+	// Perspective sets the distance in pixels the 3d transforms of the
+	// elements are viewed from, eg 800 for a card flipped with a y axis
+	// Rotate, written as the leading perspective function of the transform
+	// of each element. A zero Perspective leaves the transforms flat.
+	Perspective float64
+
+	// NoPrefix stops the vendor prefixed forms of the properties which some
+	// browsers still require them for, eg -webkit-transform, from being
+	// written ahead of their standard forms, as they are by default. It is
+	// deliberately the inverse of a Prefix option, hence the zero Stat keeps
+	// prefixing on.
+	NoPrefix bool
+
+	// ForceMotion runs the animation even when reduced motion is respected
+	// and preferred by the user, see RespectReducedMotion.
+	ForceMotion bool
+
+	// OnConflict sets how the animation starts while other animations run
+	// on the same properties of its elements, where they are replaced by
+	// default. Elements are matched by their dom element, hence separate
+	// animations of the same element conflict.
+	OnConflict ConflictPolicy
+
+	// Fill sets how the animation applies its styles outside of its run,
+	// following the css animation-fill-mode values. forwards retains the
+	// final frame after the animation ends, backwards applies the first frame
+	// during the delay, both does the two and none restores the element's
+	// styles from before the animation once it ends. An unset Fill is
+	// FillForwards. A sequence forcing its start with a From value applies
+	// its first frame during the delay whatever the Fill.
+	Fill string
+
+	// Begin fires as the animation starts. Exactly one of End and Cancel
+	// fires per run: End as the animation completes naturally and Cancel,
+	// receiving the position(0..1) within the iteration the animation got
+	// to, as it is stopped before completing.
+	Begin  Listener
+	End    Listener
+	Cancel Listener
+
+	// Progress receives the seconds elapsed within the current iteration,
+	// which restarts from zero on every iteration; the iteration count is
+	// available from the Timeline's Iteration method.
 	Progress Listener
+
+	// Repeat receives the index, counted from zero, of each iteration after
+	// the first as it starts, hence it neither fires at Begin nor after the
+	// final iteration.
+	Repeat Listener
+
+	// Pause and Resume receive the seconds elapsed within the current
+	// iteration when the Timeline is paused and resumed, firing only when
+	// its state changes.
+	Pause  Listener
+	Resume Listener
+
+	// Written receives the properties and values written to each element of
+	// the animation as its styles are written within a frame, eg to mirror
+	// the animation onto a canvas. Styles skipped by Optimize or of
+	// Discrete elements for being unchanged are not received, nor are the
+	// transitions of Native animations.
+	Written func(elem Elemental, values map[string]string)
+}
+
+// Directions defines the values of Stat.Direction.
+const (
+	DirectionNormal           = "normal"
+	DirectionReverse          = "reverse"
+	DirectionAlternate        = "alternate"
+	DirectionAlternateReverse = "alternate-reverse"
+)
+
+// Backward returns true/false if the giving iteration, counted from zero,
+// plays backward according to the stat's direction.
+func (s Stat) Backward(iteration int) bool {
+	direction := s.Direction
+	if direction == "" && s.Reverse {
+		direction = DirectionReverse
+	}
+
+	switch direction {
+	case DirectionReverse:
+		return true
+	case DirectionAlternate:
+		return iteration%2 == 1
+	case DirectionAlternateReverse:
+		return iteration%2 == 0
+	}
+
+	return false
+}
+
+// Fills defines the values of Stat.Fill.
+const (
+	FillNone      = "none"
+	FillForwards  = "forwards"
+	FillBackwards = "backwards"
+	FillBoth      = "both"
+)
+
+// fill returns the fill mode of the stat, being FillForwards when unset.
+func (s Stat) fill() string {
+	if s.Fill == "" {
+		return FillForwards
+	}
+
+	return s.Fill
+}
+
+// spring returns the spring of the stat when it uses the spring easing, else
+// returns nil.
+func (s Stat) spring() *Spring {
+	if !strings.EqualFold(s.Easing, SpringEasing) {
+		return nil
+	}
+
+	if s.Spring == nil {
+		return DefaultSpring
+	}
+
+	return s.Spring
+}
+
+// Staggered returns the stat of the element at the giving index among the
+// total elements of the animation, with its delay moved by its stagger.
+func (s Stat) Staggered(index, total int) Stat {
+	if s.StaggerReverse {
+		index = total - 1 - index
+	}
+
+	s.Delay += time.Duration(index) * s.Stagger
+	return s
+}
+
+// staggerSpan returns the time between the start of the first and the last
+// element of the animation.
+func (s Stat) staggerSpan(total int) time.Duration {
+	if total < 2 {
+		return 0
+	}
+
+	return time.Duration(total-1) * s.Stagger
+}
+
+// duration returns the duration of a single iteration of the stat, which is
+// the time its spring takes to come to rest when using the spring easing.
+func (s Stat) duration() time.Duration {
+	if sp := s.spring(); sp != nil {
+		return sp.Duration()
+	}
+
+	return s.Duration
+}
+
+// Infinite returns true/false if the stat loops forever.
+func (s Stat) Infinite() bool {
+	return s.Loop < 0
+}
+
+// LastIteration returns true/false if the giving iteration, counted from zero,
+// is the last iteration of the animation. It never returns true for an
+// infinite animation.
+func (s Stat) LastIteration(iteration int) bool {
+	if s.Infinite() {
+		return false
+	}
+
+	return iteration >= s.Loop-1
 }
 
 // SeqBev defines a sequence producer interface.
@@ -60,15 +315,32 @@ type SeqBev struct {
 
 	blocks []BlockMoment
 
+	// positions holds the position within the timeline each of the blocks
+	// was rendered at, replaying the Effectful sequences along the blocks.
+	positions []float64
+	position  float64
+
 	reversing bool
 	reversed  bool
 
-	elems Elementals
-	ideas Values
+	elems    Elementals
+	ideas    Values
+	styles   []string
+	elements []*ElementAnimation
+	props    [][]string
+	changes  []string
+	written  []string
+	discrete map[int]string
 
 	flymode  int64
 	flyIndex int64
 	simMode  int64
+
+	nl          sync.Mutex
+	nativeSpeed float64
+	nativeDone  bool
+	targets     []string
+	waits       []func()
 }
 
 // QuerySequence uses a selector to retrieve the desired elements needed
@@ -77,9 +349,24 @@ func QuerySequence(selector string, stat Stat, vs Values) *SeqBev {
 	return ElementalSequence(TransformElements(QuerySelectorAll(selector)), stat, vs)
 }
 
+// QuerySequenceIn uses a selector to retrieve the desired elements within the
+// root, eg the document of an iframe, returning the frame for the animation
+// sequence.
+func QuerySequenceIn(root Queryable, selector string, stat Stat, vs Values) *SeqBev {
+	return ElementalSequence(QuerySelectorAllIn(root, selector), stat, vs)
+}
+
+// QueryShadowSequence uses a selector to retrieve the desired elements within
+// the shadowRoot of the host, returning the frame for the animation sequence.
+// See QueryShadow.
+func QueryShadowSequence(host dom.Node, selector string, stat Stat, vs Values) *SeqBev {
+	return ElementalSequence(QueryShadow(host, selector), stat, vs)
+}
+
 // DOMSequence returns a new SeqBev transforming the lists of
 // accordingly dom.Elements into its desired elementals for the animation
-// sequence.
+// sequence. Unlike QuerySequence, exactly the provided elements are animated
+// without querying the document.
 func DOMSequence(elems []dom.Element, stat Stat, vs Values) *SeqBev {
 	return ElementalSequence(TransformElements(elems), stat, vs)
 }
@@ -96,19 +383,132 @@ func NewSeqBev(elems Elementals, stat Stat, ideas Values) *SeqBev {
 	f := SeqBev{
 		Stat:  stat,
 		elems: elems,
+		ideas: ideas,
 	}
 
-	for _, elem := range elems {
+	ideas = boundaryEasings(stat, ideas)
+
+	for index, elem := range elems {
+		// Keep the styles of the element to restore when not filling forward.
+		f.styles = append(f.styles, elem.GetAttribute("style"))
+		f.elements = append(f.elements, &ElementAnimation{
+			Elem: elem,
+			Stat: stat.Staggered(index, len(elems)),
+		})
+
+		if ie, ok := elem.(Indexable); ok {
+			ie.SetIndex(index)
+		}
+
 		// Add the sequence into the element tree.
 		elem.Add(GenerateSequence(ideas)...)
 
+		if oe, ok := elem.(interface {
+			Optimize()
+		}); ok && stat.Optimize {
+			oe.Optimize()
+		}
+
+		if pe, ok := elem.(interface {
+			Pure()
+		}); ok && stat.DryRun {
+			pe.Pure()
+		}
+
 		// Init the properties with the element.
 		elem.Init()
+
+		props := writtenProperties(elem)
+		f.props = append(f.props, props)
+
+		if stat.Optimize {
+			f.changes = append(f.changes, strings.Join(props, ", "))
+			f.written = append(f.written, "")
+		}
+
+		if dem, ok := elem.(Discrete); ok && dem.Discrete() {
+			if f.discrete == nil {
+				f.discrete = make(map[int]string)
+			}
+
+			f.discrete[index] = ""
+		}
 	}
 
 	return &f
 }
 
+// writtenProperties returns the sorted names of the properties written by the
+// sequences of the element.
+func writtenProperties(elem Elemental) []string {
+	var buf bytes.Buffer
+	elem.CSS(&buf)
+
+	styles := make(ComputedStyleMap)
+	styles.AddCSSText(buf.String())
+
+	var names []string
+	for name := range styles {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// forcesStart returns true/false if any of the values forces the start of its
+// sequence with a from value.
+func forcesStart(ideas Values) bool {
+	for _, idea := range ideas {
+		if from, ok := idea["from"]; ok && from != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// boundaryEasings returns a copy of the values where each value carries the
+// easing function of its boundary, resolved once as the animation is created.
+// A value setting its own easing overrides the easing of the stat for its
+// boundary alone, while the values without one inherit the easing of the
+// stat.
+func boundaryEasings(stat Stat, ideas Values) Values {
+	eased := make(Values, 0, len(ideas))
+
+	for _, idea := range ideas {
+		if _, ok := idea["easer"]; ok {
+			eased = append(eased, idea)
+			continue
+		}
+
+		easing, own := idea["easing"].(string)
+		if !own && stat.Easing == "" {
+			eased = append(eased, idea)
+			continue
+		}
+
+		value := make(Value, len(idea)+2)
+		for key, val := range idea {
+			value[key] = val
+		}
+
+		switch sp := stat.spring(); {
+		case own:
+			value["easer"] = GetEasing(easing)
+		case sp != nil:
+			value["easer"] = Easing(sp)
+		default:
+			value["easing"] = stat.Easing
+			value["easer"] = GetEasing(stat.Easing)
+		}
+
+		eased = append(eased, value)
+	}
+
+	return eased
+}
+
 // SimulationOFF puts off the sequence frame simulation mode returning things
 // back to normal operations.
 func (f *SeqBev) SimulationOFF() {
@@ -121,11 +521,49 @@ func (f *SeqBev) SimulationON() {
 	atomic.StoreInt64(&f.simMode, 1)
 }
 
-// Completed signifies the completion of the sequence.
+// Completed signifies the completion of the sequence, switching it into fly
+// mode where its rendered blocks are replayed.
 func (f *SeqBev) Completed(cycle int) {
 	atomic.StoreInt64(&f.flymode, 1)
 }
 
+// Rewind sets the sequence to replay its rendered blocks from the last block
+// when rendered in reverse.
+func (f *SeqBev) Rewind() {
+	atomic.StoreInt64(&f.flyIndex, int64(len(f.blocks)-1))
+}
+
+// Finish renders the final block of the sequence's replay, which is its first
+// block when replayed in reverse. The styles of the elements from before the
+// sequence are restored instead when its fill mode is none or backwards.
+func (f *SeqBev) Finish(reversed bool) {
+	if atomic.LoadInt64(&f.simMode) > 0 {
+		return
+	}
+
+	if f.Stat.fill() == FillNone || f.Stat.fill() == FillBackwards {
+		for index, elem := range f.elems {
+			if !f.elements[index].Stopped() && !f.Stat.DryRun {
+				f.forget(index)
+				scheduler.Write(Block{Elem: elem, Buf: bytes.NewBufferString(f.styles[index])})
+			}
+		}
+
+		return
+	}
+
+	if len(f.blocks) == 0 {
+		return
+	}
+
+	if reversed {
+		f.run(0)
+		return
+	}
+
+	f.run(len(f.blocks) - 1)
+}
+
 // Done returns true/false if the sequence has completed a full run.
 // Where a full run is a completed cycle + reveres run.
 func (f *SeqBev) Done() bool {
@@ -139,10 +577,6 @@ func (f *SeqBev) Done() bool {
 		return false
 	}
 
-	if f.Stat.Reverse && !f.reversed {
-		return false
-	}
-
 	return true
 }
 
@@ -167,10 +601,8 @@ func (f *SeqBev) RenderReverse(delta float64) {
 		ind = 0
 	}
 
-	blocks := f.blocks[ind]
-
 	if atomic.LoadInt64(&f.simMode) < 1 {
-		blocks.Run()
+		f.run(ind)
 	}
 
 	atomic.AddInt64(&f.flyIndex, -1)
@@ -183,20 +615,26 @@ func (f *SeqBev) Render(delta float64) {
 
 	ind := atomic.LoadInt64(&f.flyIndex)
 
+	if flymod > 0 {
+		if int(ind) < len(f.blocks) {
+			f.run(int(ind))
+		}
+
+		atomic.AddInt64(&f.flyIndex, 1)
+		return
+	}
+
 	if int(ind) >= len(f.blocks) {
 		f.blocks = append(f.blocks, []Block{})
+		f.positions = append(f.positions, 0)
 	}
 
-	blocks := f.blocks[ind]
+	f.positions[ind] = f.position
 
-	if flymod > 0 {
-		blocks.Run()
-		atomic.AddInt64(&f.flyIndex, 1)
-		return
-	}
+	blocks := f.blocks[ind]
 
 	// Build the blocks list for this current index.
-	for _, elem := range f.elems {
+	for index, elem := range f.elems {
 		elem.Blend(delta)
 
 		var buf bytes.Buffer
@@ -209,8 +647,8 @@ func (f *SeqBev) Render(delta float64) {
 
 		blocks = append(blocks, block)
 
-		if int(atomic.LoadInt64(&f.simMode)) < 1 {
-			block.Do()
+		if int(atomic.LoadInt64(&f.simMode)) < 1 && !f.elements[index].Stopped() {
+			f.write(index, block)
 		}
 	}
 
@@ -218,24 +656,240 @@ func (f *SeqBev) Render(delta float64) {
 	atomic.AddInt64(&f.flyIndex, 1)
 }
 
+// run renders the recorded blocks at the giving index, updating the
+// Effectful sequences of the elements to the position the blocks were
+// rendered at, skipping the elements whose animations have been stopped.
+func (f *SeqBev) run(ind int) {
+	for index, block := range f.blocks[ind] {
+		if index < len(f.elements) && f.elements[index].Stopped() {
+			continue
+		}
+
+		if ee, ok := block.Elem.(interface {
+			UpdateEffects(float64, float64)
+		}); ok {
+			ee.UpdateEffects(0, f.elementTimeline(index, f.positions[ind]))
+		}
+
+		f.write(index, block)
+	}
+}
+
+// write renders the block of the element at the giving index, adding the
+// perspective of the stat, the vendor prefixed forms of its properties and
+// the will-change hint of the element when optimizing.
+func (f *SeqBev) write(index int, block Block) {
+	if style, ok := rewriteStyles(block.Buf.String(), f.Stat.Perspective, !f.Stat.NoPrefix); ok {
+		block.Buf = bytes.NewBufferString(style)
+	}
+
+	if f.Stat.DryRun {
+		f.emitWritten(block)
+		return
+	}
+
+	if index >= len(f.changes) {
+		// Skip the styles of discrete elements which did not change since
+		// their last write.
+		if last, ok := f.discrete[index]; ok {
+			style := block.Buf.String()
+			if style == last {
+				return
+			}
+
+			f.discrete[index] = style
+		}
+
+		f.emitWritten(block)
+		block.Do()
+		return
+	}
+
+	// Skip the styles of the element which did not change since their last
+	// write.
+	style := block.Buf.String()
+	if style == f.written[index] {
+		return
+	}
+
+	f.written[index] = style
+	f.emitWritten(block)
+
+	if f.changes[index] == "" {
+		block.Do()
+		return
+	}
+
+	buf := bytes.NewBufferString(block.Buf.String())
+	if buf.Len() > 0 {
+		buf.WriteString(" ")
+	}
+
+	buf.WriteString("will-change: " + f.changes[index] + ";")
+
+	scheduler.Write(Block{Elem: block.Elem, Buf: buf})
+}
+
+// forget drops the last write of the element at the giving index, hence its
+// next styles are written even when unchanged.
+func (f *SeqBev) forget(index int) {
+	if index < len(f.written) {
+		f.written[index] = ""
+	}
+
+	if _, ok := f.discrete[index]; ok {
+		f.discrete[index] = ""
+	}
+}
+
+// emitWritten emits the properties and values of the block to the written
+// hook of the stat.
+func (f *SeqBev) emitWritten(block Block) {
+	if f.Stat.Written == nil {
+		return
+	}
+
+	styles := make(ComputedStyleMap)
+	styles.AddCSSText(block.Buf.String())
+
+	values := make(map[string]string, len(styles))
+	for name, style := range styles {
+		values[name] = style.Value
+	}
+
+	f.Stat.Written(block.Elem, values)
+}
+
+// restoreChanges restores the will-change property of the elements to its
+// value from before the sequence.
+func (f *SeqBev) restoreChanges() {
+	if len(f.changes) == 0 || atomic.LoadInt64(&f.simMode) > 0 || f.Stat.DryRun {
+		return
+	}
+
+	for index, elem := range f.elems {
+		style, ok := scheduler.Pending(elem)
+		if !ok {
+			style = elem.GetAttribute("style")
+		}
+
+		styles := make(ComputedStyleMap)
+		styles.AddCSSText(style)
+		delete(styles, "will-change")
+
+		prior := make(ComputedStyleMap)
+		prior.AddCSSText(f.styles[index])
+
+		if change, ok := prior["will-change"]; ok {
+			styles["will-change"] = change
+		}
+
+		scheduler.Write(Block{Elem: elem, Buf: bytes.NewBufferString(styles.String())})
+	}
+}
+
+// Claims returns the properties animated for each element of the sequence
+// which has not been stopped.
+func (f *SeqBev) Claims() map[Elemental][]string {
+	claims := make(map[Elemental][]string, len(f.elems))
+
+	for index, elem := range f.elems {
+		if !f.elements[index].Stopped() {
+			claims[elem] = f.props[index]
+		}
+	}
+
+	return claims
+}
+
+// Elements returns the animations of the individual elements of the sequence.
+func (f *SeqBev) Elements() []*ElementAnimation {
+	return append([]*ElementAnimation(nil), f.elements...)
+}
+
 //==============================================================================
 
-// EmitBegin emits the begin signal to the listener supplied in the stat.
+// EmitBegin emits the begin signal to the listener supplied in the stat. The
+// first frame is rendered at the beginning of the delay when the fill mode is
+// backwards or both, or whenever a sequence forces its start with a From
+// value, as the element then jumps to it once the delay ends.
 func (f *SeqBev) EmitBegin(delta float64) {
+	if f.Stat.Delay > 0 && (f.Stat.fill() == FillBackwards || f.Stat.fill() == FillBoth || forcesStart(f.ideas)) {
+		f.Render(0)
+	}
+
 	if f.Stat.Begin != nil {
 		f.Stat.Begin.Emit(delta)
 	}
 }
 
-// EmitProgress emits the progress signal to the listener supplied in the stat.
+// EmitProgress emits the progress signal to the listener supplied in the stat
+// and to the listeners of the animations of the individual elements, which
+// receive the progress of their own element.
 func (f *SeqBev) EmitProgress(delta float64) {
 	if f.Stat.Progress != nil {
 		f.Stat.Progress.Emit(delta)
 	}
+
+	offset := f.Stat.Delay
+	duration := f.Stat.duration().Seconds()
+
+	for _, elem := range f.elements {
+		if elem.Stopped() {
+			continue
+		}
+
+		progress := delta - (elem.Stat.Delay - offset).Seconds()
+
+		if progress < 0 {
+			progress = 0
+		}
+
+		if progress > duration {
+			progress = duration
+		}
+
+		if elem.Progress != nil {
+			elem.Progress.Emit(progress)
+		}
+	}
+}
+
+// EmitLoop emits the iteration starting to the repeat listener supplied in the
+// stat.
+func (f *SeqBev) EmitLoop(iteration int) {
+	if f.Stat.Repeat != nil {
+		f.Stat.Repeat.Emit(float64(iteration))
+	}
+}
+
+// EmitCancel emits the cancel signal to the listener supplied in the stat.
+func (f *SeqBev) EmitCancel(position float64) {
+	f.restoreChanges()
+
+	if f.Stat.Cancel != nil {
+		f.Stat.Cancel.Emit(position)
+	}
+}
+
+// EmitPause emits the pause signal to the listener supplied in the stat.
+func (f *SeqBev) EmitPause(delta float64) {
+	if f.Stat.Pause != nil {
+		f.Stat.Pause.Emit(delta)
+	}
+}
+
+// EmitResume emits the resume signal to the listener supplied in the stat.
+func (f *SeqBev) EmitResume(delta float64) {
+	if f.Stat.Resume != nil {
+		f.Stat.Resume.Emit(delta)
+	}
 }
 
 // EmitEnd emits the ending signal to the listener supplied in the stat.
 func (f *SeqBev) EmitEnd(delta float64) {
+	f.restoreChanges()
+
 	if f.Stat.End != nil {
 		f.Stat.End.Emit(delta)
 	}
@@ -250,8 +904,59 @@ func (f *SeqBev) Update(delta, total float64, timeline float64) {
 		return
 	}
 
-	for _, elem := range f.elems {
-		elem.Update(delta, timeline)
+	f.position = timeline
+
+	for index, elem := range f.elems {
+		if f.elements[index].Stopped() {
+			continue
+		}
+
+		elem.Update(delta, f.elementTimeline(index, timeline))
+	}
+}
+
+// elementTimeline returns the position(0..1) within the timeline of the
+// element at the giving index, from the position within the timeline of the
+// whole sequence, which spans the staggers of all its elements.
+func (f *SeqBev) elementTimeline(index int, timeline float64) float64 {
+	duration := f.Stat.duration().Seconds()
+
+	if f.Stat.Stagger == 0 || duration <= 0 {
+		return timeline
+	}
+
+	total := duration + f.Stat.staggerSpan(len(f.elems)).Seconds()
+	offset := (f.elements[index].Stat.Delay - f.Stat.Delay).Seconds()
+
+	local := ((timeline * total) - offset) / duration
+
+	if local < 0 {
+		return 0
+	}
+
+	if local > 1 {
+		return 1
+	}
+
+	return local
+}
+
+// RenderAt writes the styles of the elements at the giving position within
+// the timeline, without recording them for the replay of the sequence.
+func (f *SeqBev) RenderAt(total float64, timeline float64) {
+	for index, elem := range f.elems {
+		if f.elements[index].Stopped() {
+			continue
+		}
+
+		elem.Update(0, f.elementTimeline(index, timeline))
+
+		if atomic.LoadInt64(&f.simMode) < 1 {
+			var buf bytes.Buffer
+			elem.CSS(&buf)
+
+			f.write(index, Block{Elem: elem, Buf: &buf})
+		}
 	}
 }
 
@@ -261,6 +966,30 @@ func (f *SeqBev) UpdateReverse(delta float64) {
 
 //==============================================================================
 
+// ElementAnimation defines the animation of a single element of a sequence,
+// where Stat holds the timing of the element, including its stagger, and
+// Progress receives the progress of the element within its own timeline.
+type ElementAnimation struct {
+	Elem     Elemental
+	Stat     Stat
+	Progress Listener
+
+	stopped int64
+}
+
+// Stop stops the animation of the element, leaving it at its current state
+// while the other elements of the sequence keep animating.
+func (e *ElementAnimation) Stop() {
+	atomic.StoreInt64(&e.stopped, 1)
+}
+
+// Stopped returns true/false if the animation of the element was stopped.
+func (e *ElementAnimation) Stopped() bool {
+	return atomic.LoadInt64(&e.stopped) > 0
+}
+
+//==============================================================================
+
 // Listener defines an interface that provides callback hooks.
 type Listener interface {
 	Add(fn func(float64))
@@ -0,0 +1,602 @@
+package govfx_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influx6/govfx"
+	"honnef.co/go/js/dom"
+)
+
+// fakeElem provides a govfx.Elemental for an element outside of the dom,
+// whose style attribute is kept in memory alongside every style written to
+// it. It animates its Elemental when set, eg a detached govfx.Element, else
+// renders a constant opacity, and keeps its index among the animated
+// elements. The optional interfaces of its Elemental are passed through to it,
+// while its reads and writes are reported to its layout, when set.
+type fakeElem struct {
+	govfx.Elemental
+	style  string
+	styles []string
+	layout *layout
+	index  int
+}
+
+func (e *fakeElem) Init() {
+	if e.Elemental != nil {
+		e.Elemental.Init()
+	}
+}
+
+func (e *fakeElem) Reset() {
+	if e.Elemental != nil {
+		e.Elemental.Reset()
+	}
+}
+
+func (e *fakeElem) Clear() {
+	if e.Elemental != nil {
+		e.Elemental.Clear()
+	}
+}
+
+func (e *fakeElem) Add(seqs ...govfx.Sequence) {
+	if e.Elemental != nil {
+		e.Elemental.Add(seqs...)
+	}
+}
+
+func (e *fakeElem) Update(delta float64, progress float64) {
+	if e.Elemental != nil {
+		e.Elemental.Update(delta, progress)
+	}
+}
+
+func (e *fakeElem) Blend(blending float64) {
+	if e.Elemental != nil {
+		e.Elemental.Blend(blending)
+	}
+}
+
+func (e *fakeElem) CSS(w io.Writer) {
+	if e.Elemental == nil {
+		w.Write([]byte("opacity: 1;"))
+		return
+	}
+
+	e.Elemental.CSS(w)
+}
+
+func (e *fakeElem) Read(prop string, selector string) (string, bool, bool) {
+	if e.layout != nil && e.layout.dirty {
+		e.layout.dirty = false
+		e.layout.layouts++
+	}
+
+	if e.Elemental == nil {
+		return "", false, false
+	}
+
+	return e.Elemental.Read(prop, selector)
+}
+
+func (e *fakeElem) ReadInt(prop string, selector string) (int, bool, bool) {
+	if e.Elemental == nil {
+		return 0, false, false
+	}
+
+	return e.Elemental.ReadInt(prop, selector)
+}
+
+func (e *fakeElem) ReadFloat(prop string, selector string) (float64, bool, bool) {
+	if e.Elemental == nil {
+		return 0, false, false
+	}
+
+	return e.Elemental.ReadFloat(prop, selector)
+}
+
+func (e *fakeElem) Optimize() {
+	if oe, ok := e.Elemental.(interface{ Optimize() }); ok {
+		oe.Optimize()
+	}
+}
+
+func (e *fakeElem) Pure() {
+	if pe, ok := e.Elemental.(interface{ Pure() }); ok {
+		pe.Pure()
+	}
+}
+
+func (e *fakeElem) UpdateEffects(delta float64, timeline float64) {
+	if ee, ok := e.Elemental.(interface{ UpdateEffects(float64, float64) }); ok {
+		ee.UpdateEffects(delta, timeline)
+	}
+}
+
+func (e *fakeElem) Discrete() bool {
+	de, ok := e.Elemental.(govfx.Discrete)
+	return ok && de.Discrete()
+}
+
+func (e *fakeElem) Transitionable() bool {
+	te, ok := e.Elemental.(govfx.Transitionable)
+	return !ok || te.Transitionable()
+}
+
+func (e *fakeElem) Index() int {
+	return e.index
+}
+
+func (e *fakeElem) SetIndex(index int) {
+	e.index = index
+
+	if ie, ok := e.Elemental.(govfx.Indexable); ok {
+		ie.SetIndex(index)
+	}
+}
+
+func (e *fakeElem) GetAttribute(string) string {
+	return e.style
+}
+
+func (e *fakeElem) SetAttribute(name string, value string) {
+	e.style = value
+	e.styles = append(e.styles, value)
+
+	if e.layout != nil {
+		e.layout.dirty = true
+	}
+}
+
+// TestOptimize validates the behaviour of optimized animations, which set
+// will-change while running and skip writing unchanged styles.
+func TestOptimize(t *testing.T) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	var plain, optimized fakeElem
+
+	govfx.Animate(govfx.Stat{Duration: 50 * time.Millisecond}, nil, govfx.Elementals{&plain}).Start()
+	govfx.Animate(govfx.Stat{Duration: 50 * time.Millisecond, Optimize: true}, nil, govfx.Elementals{&optimized}).Start()
+
+	g.Run(100 * time.Millisecond)
+
+	if len(optimized.styles) == 0 || len(optimized.styles) >= len(plain.styles) {
+		t.Fatalf("Expected fewer writes when optimized but got %d against %d", len(optimized.styles), len(plain.styles))
+	}
+
+	for _, style := range plain.styles {
+		if strings.Contains(style, "will-change") {
+			t.Fatalf("Expected no will-change without optimizing but got %q", style)
+		}
+	}
+
+	if !strings.Contains(optimized.styles[0], "will-change: opacity;") {
+		t.Fatalf("Expected will-change to be set while running but got %q", optimized.styles[0])
+	}
+
+	if last := optimized.styles[len(optimized.styles)-1]; strings.Contains(last, "will-change") {
+		t.Fatalf("Expected will-change to be removed once ended but got %q", last)
+	}
+}
+
+// TestSequenceEasing validates the behaviour of sequences setting their own
+// easing alongside sequences inheriting the easing of the stat.
+func TestSequenceEasing(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("width: 0px; opacity: 0;"))
+	defer govfx.SetStyleProvider(nil)
+
+	elem := &fakeElem{Elemental: govfx.NewElement(nil, "")}
+
+	govfx.NewSeqBev(govfx.Elementals{elem}, govfx.Stat{Easing: "ease-out"}, govfx.Values{
+		{"animate": "width", "value": 100, "easing": "linear"},
+		{"animate": "opacity", "value": 1.0},
+	})
+
+	elem.Update(0, 0.5)
+
+	var buf bytes.Buffer
+	elem.CSS(&buf)
+
+	styles := make(govfx.ComputedStyleMap)
+	styles.AddCSSText(buf.String())
+
+	if width, _ := styles.Get("width"); width == nil || width.Value != "50px" {
+		t.Fatalf("Expected the linear width to be halfway but got %+v", width)
+	}
+
+	ease := govfx.GetEasing("ease-out").Ease(0.5)
+
+	if opacity, _ := styles.Get("opacity"); opacity == nil || govfx.ParseFloat(opacity.Value) <= 0.5 || math.Abs(govfx.ParseFloat(opacity.Value)-ease) > 0.01 {
+		t.Fatalf("Expected the opacity to ease out to %.2f but got %+v", ease, opacity)
+	}
+}
+
+// TestValueFn validates the behaviour of sequences taking the target of each
+// element from its index among the animated elements.
+func TestValueFn(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("height: 0px;"))
+	defer govfx.SetStyleProvider(nil)
+
+	bars := govfx.Elementals{
+		&fakeElem{Elemental: govfx.NewElement(nil, "")},
+		&fakeElem{Elemental: govfx.NewElement(nil, "")},
+	}
+
+	govfx.NewSeqBev(bars, govfx.Stat{Easing: "linear"}, govfx.Values{{
+		"animate": "height",
+		"value":   5,
+		"value-fn": func(index int, elem dom.Element) float64 {
+			return float64(10 * (index + 1))
+		},
+	}})
+
+	for index, bar := range bars {
+		bar.Update(0, 1)
+
+		var buf bytes.Buffer
+		bar.CSS(&buf)
+
+		if expected := fmt.Sprintf("height: %dpx;", 10*(index+1)); buf.String() != expected {
+			t.Errorf("Expected bar %d to end at %q but got %q", index, expected, buf.String())
+		}
+	}
+}
+
+// TestInteger validates the behaviour of integer sequences, which round their
+// values and only write them when they change.
+func TestInteger(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("z-index: auto;"))
+	defer govfx.SetStyleProvider(nil)
+
+	elem := &fakeElem{Elemental: govfx.NewElement(nil, "")}
+
+	var written []string
+
+	seq := govfx.NewSeqBev(govfx.Elementals{elem}, govfx.Stat{
+		Easing: "linear",
+		Written: func(_ govfx.Elemental, values map[string]string) {
+			written = append(written, values["z-index"])
+		},
+	}, govfx.Values{{"animate": "integer", "property": "z-index", "value": 2}})
+
+	for step := 1; step <= 10; step++ {
+		seq.Update(0, 0, float64(step)/10)
+		seq.Render(0)
+	}
+
+	if strings.Join(written, " ") != "0 1 2" {
+		t.Fatalf("Expected the z-index to be written as 0 1 2 but got %v", written)
+	}
+}
+
+// TestWritten validates the behaviour of the written hook of the stat, which
+// receives the values written to each element.
+func TestWritten(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("width: 0px; opacity: 0;"))
+	defer govfx.SetStyleProvider(nil)
+
+	elem := &fakeElem{Elemental: govfx.NewElement(nil, "")}
+
+	var written map[string]string
+
+	seq := govfx.NewSeqBev(govfx.Elementals{elem}, govfx.Stat{
+		Easing: "linear",
+		Written: func(em govfx.Elemental, values map[string]string) {
+			if em == govfx.Elemental(elem) {
+				written = values
+			}
+		},
+	}, govfx.Values{
+		{"animate": "width", "value": 100},
+		{"animate": "opacity", "value": 1.0},
+	})
+
+	seq.Update(0, 0, 0.5)
+	seq.Render(0)
+
+	if written["width"] != "50px" || written["opacity"] != "0.50" || len(written) != 2 {
+		t.Fatalf("Expected the written hook to receive the halfway width and opacity but got %v", written)
+	}
+}
+
+// TestPrefix validates the behaviour of the vendor prefixed forms written
+// ahead of the properties requiring them, unless the stat sets NoPrefix.
+func TestPrefix(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("transform: none;"))
+	defer govfx.SetStyleProvider(nil)
+
+	for _, noPrefix := range []bool{false, true} {
+		elem := &fakeElem{Elemental: govfx.NewElement(nil, "")}
+
+		var written map[string]string
+
+		seq := govfx.NewSeqBev(govfx.Elementals{elem}, govfx.Stat{
+			Easing:   "linear",
+			NoPrefix: noPrefix,
+			Written: func(_ govfx.Elemental, values map[string]string) {
+				written = values
+			},
+		}, govfx.Values{{"animate": "translate-x", "value": 100.0}})
+
+		seq.Update(0, 0, 0.5)
+		seq.Render(0)
+
+		_, webkit := written["-webkit-transform"]
+		_, ms := written["-ms-transform"]
+
+		if webkit == noPrefix || ms == noPrefix || written["transform"] == "" {
+			t.Errorf("Expected prefixed transforms only without NoPrefix(%t) but got %v", noPrefix, written)
+		}
+	}
+}
+
+// TestPerspective validates the behaviour of the perspective of the stat,
+// which leads the transforms of 3d rotations around their origin.
+func TestPerspective(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("transform: none;"))
+	defer govfx.SetStyleProvider(nil)
+
+	elem := &fakeElem{Elemental: govfx.NewElement(nil, "")}
+
+	var written map[string]string
+
+	seq := govfx.NewSeqBev(govfx.Elementals{elem}, govfx.Stat{
+		Easing:      "linear",
+		Perspective: 800,
+		NoPrefix:    true,
+		Written: func(_ govfx.Elemental, values map[string]string) {
+			written = values
+		},
+	}, govfx.Values{{"animate": "rotate", "value": 90.0, "axis": "y", "origin": "left center"}})
+
+	seq.Update(0, 0, 0.5)
+	seq.Render(0)
+
+	if written["transform"] != "perspective(800px) rotateY(45.00deg)" || written["transform-origin"] != "left center" {
+		t.Fatalf("Expected a y rotation through the perspective around its origin but got %v", written)
+	}
+}
+
+// TestEffect validates the behaviour of registered effects, which compose
+// sequences under a name.
+func TestEffect(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("transform: none; opacity: 1;"))
+	defer govfx.SetStyleProvider(nil)
+
+	govfx.RegisterEffect("enter", func(opts govfx.Value) govfx.Values {
+		fade, _ := govfx.Effect("fadeIn", nil)
+		slide, _ := govfx.Effect("slideInUp", opts)
+		return append(fade, slide...)
+	})
+
+	vs, err := govfx.Effect("Enter", govfx.Value{"distance": 20, "unit": "px"})
+	if err != nil {
+		t.Fatalf("Expected the enter effect to be registered: %s", err)
+	}
+
+	elem := &fakeElem{Elemental: govfx.NewElement(nil, "")}
+	govfx.NewSeqBev(govfx.Elementals{elem}, govfx.Stat{Easing: "linear"}, vs)
+
+	elem.Update(0, 0.5)
+
+	var buf bytes.Buffer
+	elem.CSS(&buf)
+
+	if expected := "opacity: 0.50; transform: translateY(10.00px);"; buf.String() != expected {
+		t.Fatalf("Expected the halfway frame to be %q but got %q", expected, buf.String())
+	}
+
+	if _, err := govfx.Effect("missing", nil); err == nil {
+		t.Fatal("Expected an unregistered effect to fail")
+	}
+}
+
+// TestDryRun validates the behaviour of dry runs, which compute the values of
+// the animation without writing them to its elements.
+func TestDryRun(t *testing.T) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	govfx.SetStyleProvider(cannedStyles("width: 0px;"))
+	defer govfx.SetStyleProvider(nil)
+
+	elem := &fakeElem{Elemental: govfx.NewElement(nil, ""), style: "color: red;"}
+
+	var written map[string]string
+
+	timeline := govfx.Animate(govfx.Stat{
+		Duration: 20 * time.Millisecond,
+		Easing:   "linear",
+		DryRun:   true,
+		Written: func(_ govfx.Elemental, values map[string]string) {
+			written = values
+		},
+	}, govfx.Values{{"animate": "width", "value": 100}}, govfx.Elementals{elem})
+
+	timeline.Seek(0.5)
+
+	if written["width"] != "50px" {
+		t.Fatalf("Expected the dry run to compute the halfway width but got %v", written)
+	}
+
+	timeline.Resume()
+	g.Run(40 * time.Millisecond)
+
+	if written["width"] != "100px" || elem.style != "color: red;" {
+		t.Fatalf("Expected the dry run to compute the final width without writing it but got %v and %q", written, elem.style)
+	}
+
+	govfx.StopAll()
+
+	live := govfx.Animate(govfx.Stat{Duration: time.Hour}, govfx.Values{{"animate": "width", "value": 100}}, govfx.Elementals{elem})
+	live.Start()
+	defer live.Stop()
+
+	dry := govfx.Animate(govfx.Stat{Duration: time.Hour, DryRun: true}, govfx.Values{{"animate": "width", "value": 50}}, govfx.Elementals{elem})
+	dry.Start()
+	defer dry.Stop()
+
+	if live.State() != govfx.Running || len(govfx.ActiveAnimations()) != 1 {
+		t.Fatalf("Expected the dry run to neither replace the live animation nor be active but got %d with %d active", live.State(), len(govfx.ActiveAnimations()))
+	}
+}
+
+// effectSeq provides an Effectful govfx.Sequence recording the positions it
+// is updated at.
+type effectSeq struct {
+	timelines []float64
+}
+
+func (e *effectSeq) Init(govfx.Elemental) {}
+func (e *effectSeq) CSS(io.Writer)        {}
+func (e *effectSeq) Effectful() bool      { return true }
+
+func (e *effectSeq) Update(_ float64, timeline float64) {
+	e.timelines = append(e.timelines, timeline)
+}
+
+// TestEffectful validates the behaviour of Effectful sequences, which are
+// updated while their sequence replays its recorded frames and never by dry
+// runs.
+func TestEffectful(t *testing.T) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	govfx.SetStyleProvider(cannedStyles("width: 0px;"))
+	defer govfx.SetStyleProvider(nil)
+
+	clock := &fakeClock{}
+	govfx.SetClock(clock)
+	defer govfx.SetClock(nil)
+
+	for _, dryRun := range []bool{false, true} {
+		effect := &effectSeq{}
+
+		elem := &fakeElem{Elemental: govfx.NewElement(nil, "")}
+		elem.Add(effect)
+
+		timeline := govfx.Animate(govfx.Stat{
+			Duration: 20 * time.Millisecond,
+			Easing:   "linear",
+			Loop:     2,
+			DryRun:   dryRun,
+		}, govfx.Values{{"animate": "width", "value": 100}}, govfx.Elementals{elem})
+		timeline.Start()
+
+		for step := 0; step < 8; step++ {
+			g.Step()
+			clock.now += 10 * time.Millisecond
+		}
+
+		if dryRun {
+			if len(effect.timelines) != 0 {
+				t.Errorf("Expected a dry run to never update the effect but got %v", effect.timelines)
+			}
+
+			continue
+		}
+
+		var restarts int
+		for index := 1; index < len(effect.timelines); index++ {
+			if effect.timelines[index] < effect.timelines[index-1] {
+				restarts++
+			}
+		}
+
+		if restarts != 1 || effect.timelines[len(effect.timelines)-1] != 1 {
+			t.Errorf("Expected the effect to run through its second iteration but got %v", effect.timelines)
+		}
+	}
+}
+
+// TestBeginFrom validates the behaviour of sequences forcing their start with
+// a From value, whose first frame is applied during the delay whatever the
+// Fill of the stat.
+func TestBeginFrom(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("opacity: 1;"))
+	defer govfx.SetStyleProvider(nil)
+
+	for _, fill := range []string{"", govfx.FillNone, govfx.FillForwards} {
+		elem := &fakeElem{Elemental: govfx.NewElement(nil, "")}
+
+		seq := govfx.NewSeqBev(govfx.Elementals{elem}, govfx.Stat{
+			Delay: time.Second,
+			Fill:  fill,
+		}, govfx.Values{{"animate": "opacity", "value": 1.0, "from": "0"}})
+
+		seq.EmitBegin(0)
+
+		if elem.style != "opacity: 0.00;" {
+			t.Errorf("Expected the From frame to be applied at the begin with the %q fill but got %q", fill, elem.style)
+		}
+	}
+}
+
+// TestFill validates the behaviour of the fill modes once the sequence ends,
+// where an unset Fill retains the final frame as forwards does.
+func TestFill(t *testing.T) {
+	govfx.SetStyleProvider(cannedStyles("opacity: 0;"))
+	defer govfx.SetStyleProvider(nil)
+
+	fills := map[string]string{
+		"":                  "opacity: 1.00;",
+		govfx.FillForwards:  "opacity: 1.00;",
+		govfx.FillBoth:      "opacity: 1.00;",
+		govfx.FillNone:      "color: red;",
+		govfx.FillBackwards: "color: red;",
+	}
+
+	for fill, expected := range fills {
+		elem := &fakeElem{Elemental: govfx.NewElement(nil, ""), style: "color: red;"}
+
+		seq := govfx.NewSeqBev(govfx.Elementals{elem}, govfx.Stat{
+			Easing: "linear",
+			Fill:   fill,
+		}, govfx.Values{{"animate": "opacity", "value": 1.0}})
+
+		seq.Update(0, 0, 1)
+		seq.Render(0)
+		seq.Completed(0)
+		seq.Finish(false)
+
+		if elem.style != expected {
+			t.Errorf("Expected the %q fill to end with %q but got %q", fill, expected, elem.style)
+		}
+	}
+}
+
+// TestDirection validates the behaviour of the directions of the iterations,
+// where Reverse is an alias of the reverse direction.
+func TestDirection(t *testing.T) {
+	directions := map[string][3]bool{
+		govfx.DirectionNormal:           {false, false, false},
+		govfx.DirectionReverse:          {true, true, true},
+		govfx.DirectionAlternate:        {false, true, false},
+		govfx.DirectionAlternateReverse: {true, false, true},
+	}
+
+	for direction, expected := range directions {
+		stat := govfx.Stat{Direction: direction}
+
+		for iteration, backward := range expected {
+			if stat.Backward(iteration) != backward {
+				t.Errorf("Expected iteration %d of %q to play backward(%t)", iteration, direction, backward)
+			}
+		}
+	}
+
+	if !(govfx.Stat{Reverse: true}).Backward(1) {
+		t.Error("Expected Reverse to play every iteration backward")
+	}
+
+	if (govfx.Stat{Reverse: true, Direction: govfx.DirectionAlternate}).Backward(0) {
+		t.Error("Expected the Direction to take precedence over Reverse")
+	}
+}
@@ -0,0 +1,141 @@
+package govfx
+
+import (
+	"sync"
+
+	"honnef.co/go/js/dom"
+)
+
+//==============================================================================
+
+// ElementQueue runs animations of an element one after the other, each
+// starting once the prior one ends, eg expanding an element, then changing
+// its color before collapsing it. Each animation reads the styles of the
+// element when it starts, hence starts from where the prior one ended.
+type ElementQueue struct {
+	ml      sync.Mutex
+	elem    dom.Element
+	items   []queueItem
+	current *Timeline
+	playing bool
+}
+
+// queueItem defines an animation waiting within an ElementQueue.
+type queueItem struct {
+	stat Stat
+	vs   Values
+}
+
+// Queue returns a new ElementQueue for the element.
+func Queue(elem dom.Element) *ElementQueue {
+	return &ElementQueue{elem: elem}
+}
+
+// Add adds an animation of the sequences to the end of the queue, which runs
+// once the animations before it end.
+func (q *ElementQueue) Add(stat Stat, vs ...Value) *ElementQueue {
+	q.ml.Lock()
+	defer q.ml.Unlock()
+
+	q.items = append(q.items, queueItem{stat: stat, vs: vs})
+	return q
+}
+
+// Play starts running the animations of the queue, unless it is already
+// playing. Returns the error of the first animation if its configuration is
+// invalid, while later animations with invalid configurations are skipped.
+func (q *ElementQueue) Play() error {
+	q.ml.Lock()
+
+	if q.playing {
+		q.ml.Unlock()
+		return nil
+	}
+
+	q.playing = true
+	q.ml.Unlock()
+
+	return q.next()
+}
+
+// Clear drops the animations waiting in the queue, letting the running
+// animation run to its end.
+func (q *ElementQueue) Clear() {
+	q.ml.Lock()
+	defer q.ml.Unlock()
+
+	q.items = nil
+}
+
+// Stop drops the animations waiting in the queue and stops the running
+// animation.
+func (q *ElementQueue) Stop() {
+	q.ml.Lock()
+	q.items = nil
+	current := q.current
+	q.ml.Unlock()
+
+	if current != nil {
+		current.Stop()
+	}
+}
+
+// Current returns the running animation of the queue, which is nil when no
+// animation is running.
+func (q *ElementQueue) Current() *Timeline {
+	q.ml.Lock()
+	defer q.ml.Unlock()
+
+	return q.current
+}
+
+// next starts the next animation of the queue, stopping the queue once it is
+// empty.
+func (q *ElementQueue) next() error {
+	q.ml.Lock()
+
+	if len(q.items) == 0 {
+		q.current = nil
+		q.playing = false
+		q.ml.Unlock()
+		return nil
+	}
+
+	item := q.items[0]
+	q.items = q.items[1:]
+	q.ml.Unlock()
+
+	stat := item.stat
+	stat.End = q.chain(item.stat.End)
+	stat.Cancel = q.chain(item.stat.Cancel)
+
+	timeline := Animate(stat, item.vs, TransformElements([]dom.Element{q.elem}))
+
+	q.ml.Lock()
+	q.current = timeline
+	q.ml.Unlock()
+
+	if err := timeline.Start(); err != nil {
+		q.next()
+		return err
+	}
+
+	return nil
+}
+
+// chain returns a listener emitting to the giving listener before starting
+// the next animation of the queue, once the styles of the ending animation
+// are written.
+func (q *ElementQueue) chain(l Listener) Listener {
+	return NewListener(func(delta float64) {
+		if l != nil {
+			l.Emit(delta)
+		}
+
+		scheduler.After(func() {
+			q.next()
+		})
+	})
+}
+
+//==============================================================================
@@ -0,0 +1,189 @@
+package govfx
+
+import (
+	"sync"
+
+	"github.com/influx6/faux/loop"
+)
+
+//==============================================================================
+
+// scheduler runs the loops of all animations off the engine.
+var scheduler = newFrameScheduler()
+
+// frameScheduler runs the loops of all animations off a single loop of the
+// engine, splitting each frame into two phases. The loops first update and
+// render their sequences, where any reads of the elements happen, while the
+// styles they render are queued, then the queued styles of all loops are
+// written to their elements together. This keeps the reads and writes of
+// concurrent animations from interleaving, which forces the browser to
+// recalculate the layout of the page for each read following a write.
+type frameScheduler struct {
+	ml     sync.Mutex
+	next   int
+	loops  []scheduledLoop
+	looper loop.Looper
+
+	wl      sync.Mutex
+	ticking bool
+	writes  []Block
+	written map[Elemental]int
+	afters  []func()
+}
+
+// scheduledLoop defines a loop run by the frameScheduler.
+type scheduledLoop struct {
+	id int
+	mx loop.Mux
+}
+
+// scheduledLooper defines the loop.Looper returned for a scheduled loop.
+type scheduledLooper struct {
+	s  *frameScheduler
+	id int
+}
+
+// End removes the loop from its scheduler.
+func (l scheduledLooper) End(fx ...func()) {
+	l.s.remove(l.id)
+
+	for _, f := range fx {
+		f()
+	}
+}
+
+// newFrameScheduler returns a new instance of a frameScheduler.
+func newFrameScheduler() *frameScheduler {
+	return &frameScheduler{written: make(map[Elemental]int)}
+}
+
+// Loop adds the mux to the loops run every frame, starting the loop of the
+// scheduler on the engine with its first loop.
+func (s *frameScheduler) Loop(mx loop.Mux) loop.Looper {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	s.next++
+	s.loops = append(s.loops, scheduledLoop{id: s.next, mx: mx})
+
+	if s.looper == nil {
+		s.looper = engine.Loop(s.tick, 0)
+	}
+
+	return scheduledLooper{s: s, id: s.next}
+}
+
+// remove removes the loop with the giving id, ending the loop of the
+// scheduler on the engine with its last loop.
+func (s *frameScheduler) remove(id int) {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	for index, item := range s.loops {
+		if item.id == id {
+			s.loops = append(s.loops[:index], s.loops[index+1:]...)
+			break
+		}
+	}
+
+	if len(s.loops) == 0 && s.looper != nil {
+		s.looper.End()
+		s.looper = nil
+	}
+}
+
+// tick runs all loops for the current frame, then writes the styles they
+// rendered before running the functions waiting on the writes.
+func (s *frameScheduler) tick(delta float64) {
+	s.ml.Lock()
+	loops := append([]scheduledLoop(nil), s.loops...)
+	s.ml.Unlock()
+
+	s.wl.Lock()
+	s.ticking = true
+	s.wl.Unlock()
+
+	// The computed styles read within the frame are reused till its end.
+	styleCache.Begin()
+
+	for _, item := range loops {
+		item.mx(delta)
+	}
+
+	afters := s.flush()
+	styleCache.End()
+
+	for _, fn := range afters {
+		fn()
+	}
+}
+
+// Write writes the block to its element, queueing it till the end of the
+// frame when written while the scheduler runs its loops. A later block for
+// the same element within the frame replaces the queued block.
+func (s *frameScheduler) Write(b Block) {
+	s.wl.Lock()
+
+	if !s.ticking {
+		s.wl.Unlock()
+		b.Elem.SetAttribute("style", b.Buf.String())
+		return
+	}
+
+	if index, ok := s.written[b.Elem]; ok {
+		s.writes[index] = b
+	} else {
+		s.written[b.Elem] = len(s.writes)
+		s.writes = append(s.writes, b)
+	}
+
+	s.wl.Unlock()
+}
+
+// After runs the function once the styles queued within the current frame are
+// written, or immediately outside of a frame, hence any reads it makes see
+// the styles of the frame.
+func (s *frameScheduler) After(fn func()) {
+	s.wl.Lock()
+
+	if !s.ticking {
+		s.wl.Unlock()
+		fn()
+		return
+	}
+
+	s.afters = append(s.afters, fn)
+	s.wl.Unlock()
+}
+
+// Pending returns the style queued for the element within the current frame.
+func (s *frameScheduler) Pending(elem Elemental) (string, bool) {
+	s.wl.Lock()
+	defer s.wl.Unlock()
+
+	index, ok := s.written[elem]
+	if !ok {
+		return "", false
+	}
+
+	return s.writes[index].Buf.String(), true
+}
+
+// flush writes the queued blocks to their elements, returning the functions
+// waiting on them.
+func (s *frameScheduler) flush() []func() {
+	s.wl.Lock()
+	writes, afters := s.writes, s.afters
+	s.writes, s.afters = nil, nil
+	s.written = make(map[Elemental]int)
+	s.ticking = false
+	s.wl.Unlock()
+
+	for _, block := range writes {
+		block.Elem.SetAttribute("style", block.Buf.String())
+	}
+
+	return afters
+}
+
+//==============================================================================
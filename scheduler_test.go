@@ -0,0 +1,49 @@
+package govfx_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influx6/govfx"
+)
+
+// layout counts the layouts a browser would be forced to recalculate, which
+// happens for every read of an element following a write to any element.
+type layout struct {
+	dirty   bool
+	layouts int
+}
+
+// BenchmarkBatchedWrites benchmarks the layouts forced by many concurrent
+// animations which read their element every frame. The writes of all
+// animations are batched at the end of each frame, hence the reads of a frame
+// force at most a single layout where interleaving them with the writes
+// would force one for every animation.
+func BenchmarkBatchedWrites(b *testing.B) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	var lay layout
+
+	for i := 0; i < 100; i++ {
+		elem := &fakeElem{layout: &lay}
+
+		timeline := govfx.Animate(govfx.Stat{
+			Duration: time.Hour,
+			Progress: govfx.NewListener(func(float64) {
+				elem.Read("opacity", "")
+			}),
+		}, nil, govfx.Elementals{elem})
+
+		timeline.Start()
+		defer timeline.Stop()
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		g.Step()
+	}
+
+	b.ReportMetric(float64(lay.layouts)/float64(b.N), "layouts/frame")
+}
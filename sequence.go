@@ -25,6 +25,44 @@ type Blending interface {
 	Blend(float64)
 }
 
+// Optimizable defines a type which can write its output using transform and
+// opacity instead, which the browser animates without recalculating the
+// layout of the page. Optimize is called before the type is initialized.
+type Optimizable interface {
+	Optimize()
+}
+
+// Transitionable defines a type which reports if its animation can be handed
+// to native css transitions, see Stat.Native. Sequences not implementing it
+// are transitioned natively, as long as they interpolate their output from a
+// start towards a target.
+type Transitionable interface {
+	Transitionable() bool
+}
+
+// Discrete defines a type whose output only changes in steps, eg integer
+// values, which often stays the same between frames. Elements whose
+// sequences are all Discrete have their styles written only when they changed
+// since their last write.
+type Discrete interface {
+	Discrete() bool
+}
+
+// Effectful defines a type changing an element other than by its styles, eg
+// its scroll position or text, which the recorded frames of a sequence do not
+// hold. Effectful types are updated on every frame, including frames replayed
+// from the records of a sequence, and are never updated by dry runs.
+type Effectful interface {
+	Effectful() bool
+}
+
+// Failable defines a type which can fail to initialize, eg a sequence whose
+// start and target values can not be interpolated, reporting its error once
+// initialized.
+type Failable interface {
+	Err() error
+}
+
 //==============================================================================
 
 // Sequence defines a series of animation step which will be runned
@@ -0,0 +1,163 @@
+package govfx
+
+import "strings"
+
+//==============================================================================
+
+// shorthandSides defines the longhands of the box shorthand properties, in
+// the order the 1/2/3/4 value rule assigns their values.
+var shorthandSides = map[string][4]string{
+	"margin":        {"margin-top", "margin-right", "margin-bottom", "margin-left"},
+	"padding":       {"padding-top", "padding-right", "padding-bottom", "padding-left"},
+	"inset":         {"top", "right", "bottom", "left"},
+	"border-width":  {"border-top-width", "border-right-width", "border-bottom-width", "border-left-width"},
+	"border-radius": {"border-top-left-radius", "border-top-right-radius", "border-bottom-right-radius", "border-bottom-left-radius"},
+}
+
+// longhandShorthands defines the shorthand property of each longhand.
+var longhandShorthands = func() map[string]string {
+	longhands := make(map[string]string)
+
+	for shorthand, sides := range shorthandSides {
+		for _, side := range sides {
+			longhands[side] = shorthand
+		}
+	}
+
+	return longhands
+}()
+
+// ExpandShorthand expands the value of a box shorthand property, eg margin,
+// padding, inset, border-width or border-radius, into its longhands following
+// the 1/2/3/4 value rule of css, eg margin: 10px 20px expands into a
+// margin-top and margin-bottom of 10px and a margin-right and margin-left of
+// 20px. The horizontal and vertical radii of a border-radius separated by a
+// slash are expanded into each corner. Returns nil if the property is not a
+// supported shorthand or the value holds too many values.
+func ExpandShorthand(prop string, value string) map[string]string {
+	sides, ok := shorthandSides[prop]
+	if !ok {
+		return nil
+	}
+
+	// A border-radius may set its vertical radii after a slash.
+	var vertical []string
+	if parts := strings.SplitN(value, "/", 2); prop == "border-radius" && len(parts) == 2 {
+		value = parts[0]
+
+		if vertical = boxValues(SplitTopLevel(parts[1], ' ')); vertical == nil {
+			return nil
+		}
+	}
+
+	values := boxValues(SplitTopLevel(value, ' '))
+	if values == nil {
+		return nil
+	}
+
+	longhands := make(map[string]string, len(sides))
+
+	for index, side := range sides {
+		longhands[side] = values[index]
+
+		if vertical != nil {
+			longhands[side] += " " + vertical[index]
+		}
+	}
+
+	return longhands
+}
+
+// CollapseShorthand collapses the longhands of a box shorthand property, eg
+// margin-top, margin-right, margin-bottom and margin-left, into the minimal
+// value of the shorthand, being the reverse of ExpandShorthand, eg four equal
+// sides collapse into a single value. The values of the sides are kept as
+// they are, without converting between their units. Returns false if the
+// property is not a supported shorthand, any side is missing or holds more
+// than a single value, or only some of the sides are !important, which a
+// shorthand can not express.
+func CollapseShorthand(prop string, sides map[string]string) (string, bool) {
+	names, ok := shorthandSides[prop]
+	if !ok {
+		return "", false
+	}
+
+	var horizontal, vertical []string
+	var important int
+
+	for _, name := range names {
+		value := strings.TrimSpace(sides[name])
+
+		if strings.HasSuffix(value, "!important") {
+			value = strings.TrimSpace(strings.TrimSuffix(value, "!important"))
+			important++
+		}
+
+		parts := SplitTopLevel(value, ' ')
+
+		switch {
+		case len(parts) == 1:
+			horizontal = append(horizontal, parts[0])
+			vertical = append(vertical, parts[0])
+		case len(parts) == 2 && prop == "border-radius":
+			horizontal = append(horizontal, parts[0])
+			vertical = append(vertical, parts[1])
+		default:
+			return "", false
+		}
+	}
+
+	if important != 0 && important != len(names) {
+		return "", false
+	}
+
+	value := strings.Join(collapseBox(horizontal), " ")
+
+	if v := strings.Join(collapseBox(vertical), " "); v != value {
+		value += " / " + v
+	}
+
+	if important > 0 {
+		value += " !important"
+	}
+
+	return value, true
+}
+
+// collapseBox reverses the 1/2/3/4 value rule of css, returning the fewest
+// values which expand into the values of the sides in the order top, right,
+// bottom and left.
+func collapseBox(values []string) []string {
+	top, right, bottom, left := values[0], values[1], values[2], values[3]
+
+	switch {
+	case right != left:
+		return values
+	case top != bottom:
+		return []string{top, right, bottom}
+	case top != right:
+		return []string{top, right}
+	}
+
+	return []string{top}
+}
+
+// boxValues applies the 1/2/3/4 value rule of css to the values, returning
+// the value of each side in the order top, right, bottom and left. Returns
+// nil if no or more than four values are provided.
+func boxValues(values []string) []string {
+	switch len(values) {
+	case 1:
+		return []string{values[0], values[0], values[0], values[0]}
+	case 2:
+		return []string{values[0], values[1], values[0], values[1]}
+	case 3:
+		return []string{values[0], values[1], values[2], values[1]}
+	case 4:
+		return values
+	}
+
+	return nil
+}
+
+//==============================================================================
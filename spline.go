@@ -1,5 +1,7 @@
 package govfx
 
+import "math"
+
 //==============================================================================
 
 // PropertyCurves provides a interface for easing values using curves data
@@ -32,6 +34,14 @@ func NewSpline(x, y, x2, y2 float64) *Spline {
 // Ease implements the Easings interface and allows us to use a spline
 // to provide easing behaviours.
 func (s *Spline) Ease(pos float64) float64 {
+	if pos <= 0 {
+		return 0
+	}
+
+	if pos >= 1 {
+		return 1
+	}
+
 	return s.X(pos)
 }
 
@@ -49,24 +59,55 @@ func (s *Spline) X(t float64) float64 {
 	return CalculateBezier(s.GetTimeForX(t), s.y1, s.y2)
 }
 
+// splinePrecision defines the precision to which the time for a x coordinate
+// is solved.
+const splinePrecision = 1e-7
+
 // GetTimeForX returns the giving time value between 0 and 1 for the provided
 // x coordinate for a bezier curve.
 func (s *Spline) GetTimeForX(aX float64) float64 {
 	// Newton raphson iteration
 	aGuessT := aX
 
-	for i := 0; i < 4; i++ {
-
-		currentSlope := GetSlope(aGuessT, s.x1, s.y1)
+	for i := 0; i < 8; i++ {
+		currentX := CalculateBezier(aGuessT, s.x1, s.x2) - aX
 
-		if currentSlope == 0.0 {
+		if math.Abs(currentX) < splinePrecision {
 			return aGuessT
 		}
 
-		currentX := CalculateBezier(aGuessT, s.x1, s.x2) - aX
+		currentSlope := GetSlope(aGuessT, s.x1, s.x2)
+
+		if math.Abs(currentSlope) < splinePrecision {
+			break
+		}
 
 		aGuessT -= currentX / currentSlope
+	}
+
+	// Fall back to bisection where newton raphson fails to converge, as the
+	// curve flattens out.
+	lower, upper := 0.0, 1.0
+	aGuessT = aX
+
+	for lower < upper {
+		currentX := CalculateBezier(aGuessT, s.x1, s.x2)
+
+		if math.Abs(currentX-aX) < splinePrecision {
+			return aGuessT
+		}
+
+		if aX > currentX {
+			lower = aGuessT
+		} else {
+			upper = aGuessT
+		}
+
+		if upper-lower < splinePrecision {
+			break
+		}
 
+		aGuessT = (upper-lower)/2 + lower
 	}
 
 	return aGuessT
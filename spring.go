@@ -0,0 +1,104 @@
+package govfx
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+//==============================================================================
+
+// SpringEasing defines the easing name which selects the spring model for a
+// stat, where the duration of the animation is emergent from its spring.
+const SpringEasing = "spring"
+
+// springStep defines the step in seconds with which the spring is integrated.
+const springStep = 0.001
+
+// springRest defines the threshold of displacement and velocity below which
+// a spring is considered at rest.
+const springRest = 0.001
+
+// maxSpringDuration defines the maximum time a spring may take to come to
+// rest, capping springs which barely damp.
+const maxSpringDuration = 10 * time.Second
+
+// DefaultSpring defines the spring used when a stat selects the spring easing
+// without providing its own.
+var DefaultSpring = &Spring{Stiffness: 100, Damping: 10, Mass: 1}
+
+// Spring defines a physics based easing modelled on a damped spring moving
+// from 0 to its rest at 1. Velocity sets the initial velocity of the spring in
+// units of the total distance per second. Zero Stiffness and Mass values use
+// the values of DefaultSpring and a zero Damping leaves the spring to
+// oscillate until it is capped by its maximum duration.
+type Spring struct {
+	Stiffness float64
+	Damping   float64
+	Mass      float64
+	Velocity  float64
+
+	once    sync.Once
+	samples []float64
+}
+
+// Duration returns the time the spring takes to come to rest.
+func (s *Spring) Duration() time.Duration {
+	s.once.Do(s.integrate)
+	return time.Duration(float64(len(s.samples)-1) * springStep * float64(time.Second))
+}
+
+// Ease returns the displacement of the spring at the giving fraction of its
+// duration, which may overshoot 1 for springs that oscillate.
+func (s *Spring) Ease(t float64) float64 {
+	s.once.Do(s.integrate)
+
+	if t <= 0 {
+		return 0
+	}
+
+	if t >= 1 {
+		return 1
+	}
+
+	pos := t * float64(len(s.samples)-1)
+	index := int(pos)
+
+	return lerp(s.samples[index], s.samples[index+1], pos-float64(index))
+}
+
+// integrate integrates the spring equation until the spring comes to rest,
+// storing the displacement of each step.
+func (s *Spring) integrate() {
+	stiffness, mass := s.Stiffness, s.Mass
+
+	if stiffness <= 0 {
+		stiffness = DefaultSpring.Stiffness
+	}
+
+	if mass <= 0 {
+		mass = DefaultSpring.Mass
+	}
+
+	maxSteps := int(maxSpringDuration.Seconds() / springStep)
+
+	var x float64
+	v := s.Velocity
+
+	s.samples = append(s.samples[:0], x)
+
+	for step := 0; step < maxSteps; step++ {
+		force := -stiffness*(x-1) - s.Damping*v
+
+		v += (force / mass) * springStep
+		x += v * springStep
+
+		s.samples = append(s.samples, x)
+
+		if math.Abs(x-1) < springRest && math.Abs(v) < springRest {
+			break
+		}
+	}
+}
+
+//==============================================================================
@@ -0,0 +1,217 @@
+package govfx
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/influx6/faux/loop"
+)
+
+//==============================================================================
+
+// ErrLabelNotFound is returned when adding a timeline at a label which has not
+// been marked on the storyboard.
+var ErrLabelNotFound = errors.New("Label not found")
+
+// Storyboard choreographs multiple timelines, starting each at its offset
+// from the start of the storyboard. All timelines of a storyboard are driven
+// off its single clock, hence stay in sync. Begin is emitted when the first
+// timeline starts and End when the last timeline ends, which never happens
+// if any of its timelines loops forever.
+type Storyboard struct {
+	Begin Listener
+	End   Listener
+
+	ml      sync.Mutex
+	entries []*storyEntry
+	labels  map[string]time.Duration
+
+	start   time.Time
+	looper  loop.Looper
+	running int64
+	began   bool
+}
+
+// storyEntry defines a timeline of a storyboard and the offset it starts at.
+type storyEntry struct {
+	timeline *Timeline
+	at       time.Duration
+	started  bool
+}
+
+// NewStoryboard returns a new instance of a Storyboard.
+func NewStoryboard() *Storyboard {
+	return &Storyboard{labels: make(map[string]time.Duration)}
+}
+
+// Add adds the timeline to the storyboard, starting at the giving offset from
+// the start of the storyboard. A negative offset shifts the start of the
+// other timelines of the storyboard forward by the same amount.
+func (s *Storyboard) Add(t *Timeline, at time.Duration) *Storyboard {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	t.shared = true
+	s.entries = append(s.entries, &storyEntry{timeline: t, at: at})
+	return s
+}
+
+// Label marks the current end of the storyboard, where its last ending
+// timeline ends, with the giving name.
+func (s *Storyboard) Label(name string) *Storyboard {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	var end time.Duration
+
+	for _, entry := range s.entries {
+		at := entry.at
+
+		if span, ok := entry.timeline.span(); ok {
+			at += span
+		}
+
+		if at > end {
+			end = at
+		}
+	}
+
+	s.labels[name] = end
+	return s
+}
+
+// AddAt adds the timeline to the storyboard, starting at the giving label
+// moved by the optional offset, where a negative offset overlaps the
+// timelines before the label. Returns ErrLabelNotFound if the label does not
+// exist.
+func (s *Storyboard) AddAt(t *Timeline, label string, offset ...time.Duration) error {
+	s.ml.Lock()
+	at, ok := s.labels[label]
+	s.ml.Unlock()
+
+	if !ok {
+		return ErrLabelNotFound
+	}
+
+	for _, off := range offset {
+		at += off
+	}
+
+	s.Add(t, at)
+	return nil
+}
+
+// Animate starts the clock of the storyboard. Returns the error of the first
+// timeline with an invalid configuration without starting the storyboard.
+func (s *Storyboard) Animate() error {
+	if !atomic.CompareAndSwapInt64(&s.running, 0, 1) {
+		return nil
+	}
+
+	s.ml.Lock()
+
+	var earliest time.Duration
+
+	for _, entry := range s.entries {
+		if entry.timeline.err != nil {
+			s.ml.Unlock()
+			atomic.StoreInt64(&s.running, 0)
+			return entry.timeline.err
+		}
+
+		if entry.at < earliest {
+			earliest = entry.at
+		}
+	}
+
+	// Shift all timelines, so the earliest starts with the storyboard.
+	for _, entry := range s.entries {
+		entry.at -= earliest
+	}
+
+	s.start = clockNow()
+	s.ml.Unlock()
+
+	s.looper = scheduler.Loop(func(delta float64) {
+		s.tick()
+	})
+
+	return nil
+}
+
+// Stop halts the storyboard, stopping all its started timelines.
+func (s *Storyboard) Stop() {
+	if !atomic.CompareAndSwapInt64(&s.running, 1, 0) {
+		return
+	}
+
+	s.looper.End()
+
+	s.ml.Lock()
+	entries := append([]*storyEntry(nil), s.entries...)
+	s.ml.Unlock()
+
+	for _, entry := range entries {
+		if entry.started {
+			entry.timeline.Stop()
+		}
+	}
+}
+
+// tick starts the timelines whose offsets have been reached and steps the
+// timers of all started timelines.
+func (s *Storyboard) tick() {
+	if atomic.LoadInt64(&s.running) < 1 {
+		return
+	}
+
+	elapsed := clockSince(s.start)
+
+	s.ml.Lock()
+	entries := append([]*storyEntry(nil), s.entries...)
+	s.ml.Unlock()
+
+	ended := true
+
+	for _, entry := range entries {
+		if !entry.started && elapsed >= entry.at {
+			entry.started = true
+			entry.timeline.Start()
+
+			if !s.began {
+				s.began = true
+
+				if s.Begin != nil {
+					s.Begin.Emit(elapsed.Seconds())
+				}
+			}
+		}
+
+		if !entry.started {
+			ended = false
+			continue
+		}
+
+		if timer := entry.timeline.timer; timer != nil && !entry.timeline.ended() {
+			timer.Update()
+		}
+
+		if !entry.timeline.ended() {
+			ended = false
+		}
+	}
+
+	if !ended || !atomic.CompareAndSwapInt64(&s.running, 1, 0) {
+		return
+	}
+
+	s.looper.End()
+
+	if s.End != nil {
+		s.End.Emit(elapsed.Seconds())
+	}
+}
+
+//==============================================================================
@@ -1,6 +1,7 @@
 package govfx
 
 import (
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -24,6 +25,25 @@ type TimelineEmitable interface {
 	EmitProgress(float64)
 }
 
+// TimelineLoopEmitable defines an interface for structures with the desire to
+// receive notification as each iteration after the first starts.
+type TimelineLoopEmitable interface {
+	EmitLoop(iteration int)
+}
+
+// TimelineCancelEmitable defines an interface for structures with the desire
+// to receive notification as the timeline is stopped before completing.
+type TimelineCancelEmitable interface {
+	EmitCancel(position float64)
+}
+
+// TimelinePauseEmitable defines an interface for structures with the desire to
+// receive notification as the timeline is paused and resumed.
+type TimelinePauseEmitable interface {
+	EmitPause(float64)
+	EmitResume(float64)
+}
+
 // TimelineBehaviour defines a interface for callable structures from a timeline
 // provider.
 type TimelineBehaviour interface {
@@ -43,6 +63,46 @@ type TimelineBehaviourSimulationFlag interface {
 	SimulationOFF()
 }
 
+// TimelineBehaviourReplay defines a interface for behaviours which replay
+// their rendered sequence on later iterations, allowing the timeline to
+// rewind to the end of the sequence for iterations replaying it backward and
+// to render the final state of the last replay.
+type TimelineBehaviourReplay interface {
+	Rewind()
+	Finish(reversed bool)
+}
+
+// TimelineBehaviourSeekable defines a interface for behaviours which can
+// render any position within their timeline on demand, writing it without
+// recording it for their replay.
+type TimelineBehaviourSeekable interface {
+	RenderAt(progress float64, timeline float64)
+}
+
+// TimelineBehaviourNative defines a interface for behaviours which can hand
+// their animation to native css transitions, see Stat.Native. Transition is
+// only called when Transitionable returns true and calls ended once the
+// transitions end, unless StopTransition is called with the time elapsed
+// since the transitions were set.
+type TimelineBehaviourNative interface {
+	Transitionable() bool
+	Transition(speed float64, ended func())
+	StopTransition(elapsed time.Duration)
+}
+
+// AnimationState defines the state of a timeline.
+type AnimationState int
+
+// contains the states of a timeline, where the delay of a started timeline
+// counts as Running.
+const (
+	Idle AnimationState = iota
+	Running
+	Paused
+	Completed
+	Cancelled
+)
+
 // Timeline defines a struct to manage the behaviour of a animation frame.
 type Timeline struct {
 	stat Stat
@@ -51,26 +111,32 @@ type Timeline struct {
 	tmMod ModeTimer
 	timer Timeable
 
-	start time.Time
+	start    time.Time
+	rendered time.Time
 
 	progress float64
 
-	beating  int64
-	paused   int64
-	dead     int64
-	loop     int64
-	loopDone int64
+	beating   int64
+	pending   int64
+	begun     int64
+	paused    int64
+	dead      int64
+	cancelled int64
+	native    int64
+	loop      int64
+	loopDone  int64
+	iteration int64
 
 	loopInfinite bool
 	loops        bool
 
-	reversed     bool
-	reversedDone bool
-	completed    bool
-	reclocking   bool
+	reversed   bool
+	completed  bool
+	reclocking bool
 
 	beginOnce sync.Once
 	endOnce   sync.Once
+	done      chan struct{}
 
 	simulated     chan struct{}
 	simulationON  bool
@@ -78,19 +144,42 @@ type Timeline struct {
 	simulatedDone bool
 
 	timeline time.Duration
+	speed    float64
+	shared   bool
+	easer    Easing
+
+	err error
 }
 
 // NewTimeline returns a new timeline to manage the lifetime of a animation.
 func NewTimeline(mt ModeTimer, t TimelineBehaviour, stat Stat) *Timeline {
-	tm := Timeline{tmMod: mt, stat: stat, tb: t, simulated: make(chan struct{})}
+	tm := Timeline{tmMod: mt, stat: stat, tb: t, simulated: make(chan struct{}), done: make(chan struct{})}
 
 	// Setup loop flags.
 	tm.loop = int64(stat.Loop)
 	tm.loops = (stat.Loop < 0 || stat.Loop > 0)
 	tm.loopInfinite = stat.Loop < 0
 
-	// Set up core variables.
-	tm.timeline = stat.Duration + stat.Delay
+	// Set up core variables. The delay is waited out by the timer before it
+	// begins progressing, hence only the duration makes up the timeline.
+	// A spring runs until it comes to rest, regardless of the duration.
+	tm.timeline = stat.duration()
+
+	if stat.Easing != "" {
+		_, tm.err = ParseEasing(stat.Easing)
+	}
+
+	// The easing of the stat eases the progress reported by its frames.
+	tm.easer = GetEasing(stat.Easing)
+	if sp := stat.spring(); sp != nil {
+		tm.easer = sp
+	}
+
+	// A unset speed plays the timeline at its normal rate.
+	tm.speed = stat.Speed
+	if tm.speed == 0 {
+		tm.speed = 1
+	}
 
 	return &tm
 }
@@ -116,67 +205,468 @@ func (t *Timeline) Simulate() <-chan struct{} {
 		return t.simulated
 	}
 
+	if t.err != nil {
+		t.simulatedOnce.Do(func() {
+			close(t.simulated)
+		})
+
+		return t.simulated
+	}
+
 	t.simulationON = true
 	sim.SimulationON()
 	t.Start()
 	return t.simulated
 }
 
-// Resume unpauses the timeline operations if its started.
+// Resume unpauses the timeline operations if its started and paused.
 func (t *Timeline) Resume() {
-	if atomic.LoadInt64(&t.beating) < 1 {
+	if atomic.LoadInt64(&t.beating) < 1 || atomic.LoadInt64(&t.dead) > 0 || atomic.LoadInt64(&t.native) > 0 {
+		return
+	}
+
+	if !atomic.CompareAndSwapInt64(&t.paused, 1, 0) {
 		return
 	}
 
-	atomic.StoreInt64(&t.paused, 0)
 	t.timer.Resume()
+
+	if fb, ok := t.tb.(TimelinePauseEmitable); ok {
+		fb.EmitResume(t.progress)
+	}
 }
 
-// Pause pauses the timeline operations if its started.
+// Pause pauses the timeline operations if its started and not paused.
 func (t *Timeline) Pause() {
+	if atomic.LoadInt64(&t.beating) < 1 || atomic.LoadInt64(&t.dead) > 0 || atomic.LoadInt64(&t.native) > 0 {
+		return
+	}
+
+	if !atomic.CompareAndSwapInt64(&t.paused, 0, 1) {
+		return
+	}
+
+	t.timer.Pause()
+
+	if fb, ok := t.tb.(TimelinePauseEmitable); ok {
+		fb.EmitPause(t.progress)
+	}
+}
+
+// Step pauses the timeline and advances it by dt within its current
+// iteration, rendering and writing the styles of its new position before
+// emitting its progress, where a negative dt scrubs it backward. A timeline
+// not yet started is started first, without waiting out its delay. Stepping
+// past either end of the iteration stops at that end, where Resume continues
+// the automatic run from the stepped position, emitting the end signal once
+// it runs out. Native timelines can not be stepped.
+func (t *Timeline) Step(dt time.Duration) {
+	t.scrub(func(progress float64) float64 {
+		// The steps are summed in nanoseconds, sparing the drift of summing
+		// fractions of seconds.
+		return (time.Duration(math.Round(progress*float64(time.Second))) + dt).Seconds()
+	})
+}
+
+// Seek pauses the timeline and jumps to the position(0..1) of the time within
+// its current iteration, rendering and writing the styles of the position as
+// eased by the sequences before emitting its progress, following the rules
+// of Step. Seeking to 0 or 1 renders the first or final frame of the
+// iteration without emitting the begin or end signal, the end signal is
+// emitted as the timeline is resumed from there.
+func (t *Timeline) Seek(position float64) {
+	t.scrub(func(float64) float64 {
+		return position * t.timeline.Seconds()
+	})
+}
+
+// scrub pauses the timeline and moves it to the progress returned for its
+// current progress, clamped within the iteration, rendering its position and
+// emitting its progress.
+func (t *Timeline) scrub(to func(progress float64) float64) {
+	if atomic.LoadInt64(&t.beating) < 1 {
+		if err := t.Start(); err != nil {
+			return
+		}
+	}
+
+	if atomic.LoadInt64(&t.dead) > 0 || atomic.LoadInt64(&t.native) > 0 || t.timer == nil {
+		return
+	}
+
+	t.Pause()
+
+	tm, ok := t.timer.(*timer)
+	if ok && !tm.hasBegun() {
+		tm.init()
+	}
+
+	progress := math.Max(0, math.Min(to(t.progress), t.timeline.Seconds()))
+
+	t.progress = progress
+	t.reclocking = false
+
+	if ok {
+		tm.seek(progress)
+	}
+
+	if sb, ok := t.tb.(TimelineBehaviourSeekable); ok {
+		sb.RenderAt(progress, t.fraction(progress))
+	}
+
+	if fb, ok := t.tb.(TimelineEmitable); ok {
+		fb.EmitProgress(progress)
+	}
+}
+
+// Speed returns the current playback rate of the timeline.
+func (t *Timeline) Speed() float64 {
+	return t.speed
+}
+
+// SetSpeed sets the playback rate of the timeline, taking effect immediately
+// if its started. A speed of 0 freezes the timeline in place until a new
+// speed is set.
+func (t *Timeline) SetSpeed(speed float64) {
+	if speed < 0 {
+		speed = 0
+	}
+
+	t.speed = speed
+
+	if t.timer != nil {
+		t.timer.SetSpeed(speed)
+	}
+}
+
+// Stop halts the timeline operations if its started, leaving its elements at
+// the state of their current frame. The cancel signal is emitted with the
+// position within the timeline it was stopped at, unless the timeline has
+// already ended, in place of the end signal. A timeline stopped before its
+// start is cancelled without emitting any signal and never starts.
+func (t *Timeline) Stop() {
+	// An enqueued timeline stopped while it waits never starts.
+	if atomic.CompareAndSwapInt64(&t.pending, 1, 0) {
+		t.endOnce.Do(func() {
+			defer close(t.done)
+			atomic.StoreInt64(&t.cancelled, 1)
+			atomic.StoreInt64(&t.dead, 1)
+
+			if fb, ok := t.tb.(TimelineCancelEmitable); ok {
+				fb.EmitCancel(0)
+			}
+		})
+
+		return
+	}
+
 	if atomic.LoadInt64(&t.beating) < 1 {
+		t.abandon()
+		return
+	}
+
+	nb, native := t.tb.(TimelineBehaviourNative)
+	native = native && atomic.LoadInt64(&t.native) > 0
+
+	dead := t.ended()
+
+	t.endOnce.Do(func() {
+		defer close(t.done)
+		atomic.StoreInt64(&t.cancelled, 1)
+		atomic.StoreInt64(&t.dead, 1)
+
+		// Native transitions are frozen at the styles of the time elapsed.
+		if native {
+			elapsed := clockSince(t.start)
+			t.progress = math.Max(0, math.Min((elapsed-t.stat.Delay).Seconds()*t.speed, t.timeline.Seconds()))
+			nb.StopTransition(elapsed)
+		}
+
+		if fb, ok := t.tb.(TimelineCancelEmitable); ok {
+			fb.EmitCancel(t.position())
+		}
+	})
+
+	// Timelines finished instantly under reduced motion never run a timer.
+	if native || dead || t.timer == nil {
 		return
 	}
 
-	atomic.StoreInt64(&t.paused, 1)
 	t.timer.Pause()
+	StopTimer(t.timer)
 }
 
-// Start loads the timeline animation to the run loop.
-func (t *Timeline) Start() {
+// Start loads the timeline animation to the run loop. Returns an error without
+// starting the timeline if its configuration is invalid, eg a unknown or
+// malformed easing, or ErrConflict if it ignores conflicts while another
+// timeline animates the same properties of its elements, cancelling the
+// timeline in either case. Ended or stopped timelines are not started again.
+func (t *Timeline) Start() error {
+	if t.err != nil {
+		t.abandon()
+		return t.err
+	}
+
+	if t.ended() {
+		return nil
+	}
+
 	if atomic.LoadInt64(&t.paused) > 0 {
-		return
+		return nil
+	}
+
+	// Dry runs write nothing, hence conflict with no other timeline.
+	if !t.simulationON && !t.stat.DryRun && atomic.LoadInt64(&t.beating) < 1 {
+		if conflicts := claims.Claim(t); len(conflicts) > 0 {
+			switch t.stat.OnConflict {
+			case Ignore:
+				t.abandon()
+				return ErrConflict
+			case Enqueue:
+				if atomic.CompareAndSwapInt64(&t.pending, 0, 1) {
+					go t.startAfter(conflicts)
+				}
+
+				return nil
+			}
+
+			for _, conflict := range conflicts {
+				conflict.Stop()
+			}
+		}
 	}
 
 	atomic.StoreInt64(&t.beating, 1)
+
+	if !t.simulationON && !t.stat.DryRun {
+		active.Add(t)
+	}
+
+	if !t.stat.ForceMotion && ReducedMotion() {
+		t.finishInstantly()
+		return nil
+	}
+
+	if t.stat.Native && t.transition() {
+		return nil
+	}
+
+	t.runTimer()
+
+	return nil
+}
+
+// transition hands the timeline to the native css transitions of its
+// behaviour, emitting its begin signal as the transitions are set and its end
+// signal once they end. Returns false without transitioning if the timeline
+// loops, plays backward or its behaviour can not be transitioned.
+func (t *Timeline) transition() bool {
+	nb, ok := t.tb.(TimelineBehaviourNative)
+	if !ok || t.simulationON || t.stat.DryRun || t.loopInfinite || t.stat.Loop > 1 || t.stat.Backward(0) || t.speed <= 0 {
+		return false
+	}
+
+	if !nb.Transitionable() {
+		return false
+	}
+
+	atomic.StoreInt64(&t.native, 1)
+	t.start = clockNow()
+
+	t.begin(0)
+
+	fb, emits := t.tb.(TimelineEmitable)
+
+	nb.Transition(t.speed, func() {
+		t.endOnce.Do(func() {
+			defer close(t.done)
+			t.progress = t.timeline.Seconds()
+			t.tb.Completed(0)
+			atomic.StoreInt64(&t.dead, 1)
+			if emits {
+				fb.EmitEnd(t.progress)
+			}
+		})
+	})
+
+	return true
+}
+
+// startAfter starts the timeline once the conflicting timelines end, unless
+// it was stopped while waiting.
+func (t *Timeline) startAfter(conflicts []*Timeline) {
+	for _, conflict := range conflicts {
+		conflict.Wait()
+	}
+
+	if atomic.CompareAndSwapInt64(&t.pending, 1, 0) {
+		t.Start()
+	}
+}
+
+// finishInstantly renders the final state of the last iteration of the
+// timeline without animating, emitting its begin and end signals.
+func (t *Timeline) finishInstantly() {
+	if t.stat.Loop > 1 {
+		atomic.StoreInt64(&t.iteration, int64(t.stat.Loop-1))
+	}
+
+	t.progress = t.timeline.Seconds()
+
+	t.begin(0)
+
+	fb, emits := t.tb.(TimelineEmitable)
+
+	t.tb.Update(0, t.progress, t.fraction(t.progress))
+	t.tb.Render(0)
+	t.tb.Completed(0)
+
+	if rp, ok := t.tb.(TimelineBehaviourReplay); ok {
+		rp.Finish(false)
+	}
+
+	t.endOnce.Do(func() {
+		defer close(t.done)
+		atomic.StoreInt64(&t.dead, 1)
+		if emits {
+			fb.EmitEnd(t.progress)
+		}
+	})
+}
+
+// abandon cancels the timeline without it having started, closing its done
+// channel without emitting any signal.
+func (t *Timeline) abandon() {
+	t.endOnce.Do(func() {
+		defer close(t.done)
+		atomic.StoreInt64(&t.cancelled, 1)
+		atomic.StoreInt64(&t.dead, 1)
+	})
+}
+
+// Done returns a channel closed once the timeline ends or is stopped, which is
+// the same channel on every call.
+func (t *Timeline) Done() <-chan struct{} {
+	return t.done
+}
+
+// Wait blocks until the timeline ends or is stopped.
+func (t *Timeline) Wait() {
+	<-t.done
+}
+
+// State returns the current state of the timeline. A timeline is Idle until
+// started, Running from its start including its delay and any wait behind the
+// conflicts it enqueued after, Paused while paused, then Completed once it ends
+// or Cancelled once stopped before its end or refused by Start.
+func (t *Timeline) State() AnimationState {
+	switch {
+	case atomic.LoadInt64(&t.cancelled) > 0:
+		return Cancelled
+	case atomic.LoadInt64(&t.dead) > 0:
+		return Completed
+	case atomic.LoadInt64(&t.pending) > 0:
+		return Running
+	case atomic.LoadInt64(&t.beating) < 1:
+		return Idle
+	case atomic.LoadInt64(&t.paused) > 0:
+		return Paused
+	}
+
+	return Running
+}
+
+// IsRunning returns true/false if the timeline has started and neither is
+// paused nor has ended.
+func (t *Timeline) IsRunning() bool {
+	return t.State() == Running
+}
+
+// ended returns true/false if the timeline has ended or was stopped.
+func (t *Timeline) ended() bool {
+	return atomic.LoadInt64(&t.dead) > 0
+}
+
+// runTimer creates a new timer for the timeline, running it on the engine
+// loop unless the timeline is driven by the shared clock of a Storyboard.
+func (t *Timeline) runTimer() {
 	t.timer = NewTimer(t, t.tmMod)
-	stopCache.Add(t.timer, engine.Loop(func(delta float64) {
+	t.timer.SetSpeed(t.speed)
+
+	if t.shared {
+		return
+	}
+
+	stopCache.Add(t.timer, scheduler.Loop(func(delta float64) {
 		t.timer.Update()
-	}, 0))
+	}))
+}
+
+// span returns the total running time of the timeline across its iterations,
+// returning false if the timeline loops forever.
+func (t *Timeline) span() (time.Duration, bool) {
+	if t.stat.Infinite() {
+		return 0, false
+	}
+
+	iteration := t.timeline
+
+	if t.speed > 0 {
+		iteration = time.Duration(float64(iteration) / t.speed)
+	}
+
+	iterations := t.stat.Loop
+	if iterations < 1 {
+		iterations = 1
+	}
+
+	return t.stat.Delay + time.Duration(iterations)*iteration + time.Duration(iterations-1)*t.stat.LoopDelay, true
 }
 
 // Begin sets the timeline ready to begin to clocking its behaviours
 // update and render cycles.
 func (t *Timeline) Begin(begin time.Time) {
+
+	// Position the behaviour at the start of the timeline before its first
+	// render, which is the end of it for iterations playing backward.
+	t.tb.Update(0, 0, t.fraction(0))
+
 	if t.simulationON {
 		return
 	}
 
 	t.start = begin
 
-	if fb, ok := t.tb.(TimelineEmitable); ok {
-		t.beginOnce.Do(func() {
-			fb.EmitBegin(time.Since(begin).Seconds())
-		})
-	}
+	t.begin(clockSince(begin).Seconds())
+}
+
+// begin emits the begin signal of the timeline once, marking it as begun.
+func (t *Timeline) begin(at float64) {
+	t.beginOnce.Do(func() {
+		atomic.StoreInt64(&t.begun, 1)
+
+		if fb, ok := t.tb.(TimelineEmitable); ok {
+			fb.EmitBegin(at)
+		}
+	})
 }
 
 // Render implements the TimeBehaviour interface Render() function.
 func (t *Timeline) Render(delta float64) {
-	if atomic.LoadInt64(&t.paused) > 0 {
+	if atomic.LoadInt64(&t.paused) > 0 || atomic.LoadInt64(&t.dead) > 0 {
 		return
 	}
 
+	// Skip the frames rendering faster than the cap of the stat allows.
+	if t.stat.MaxFPS > 0 {
+		now := clockNow()
+		if now.Sub(t.rendered) < time.Second/time.Duration(t.stat.MaxFPS) {
+			return
+		}
+
+		t.rendered = now
+	}
+
 	if t.reversed {
 		t.tb.RenderReverse(delta)
 	} else {
@@ -190,8 +680,54 @@ func (t *Timeline) Render(delta float64) {
 	}
 }
 
+// Iteration returns the current iteration of the timeline, counted from zero.
+func (t *Timeline) Iteration() int {
+	return int(atomic.LoadInt64(&t.iteration))
+}
+
+// Frame returns a snapshot of the current state of the timeline.
+func (t *Timeline) Frame() Frame {
+	raw := t.fraction(t.progress)
+
+	total := t.stat.Loop
+	if total == 0 {
+		total = 1
+	}
+
+	return Frame{
+		progress:  t.easer.Ease(raw),
+		raw:       raw,
+		elapsed:   time.Duration(t.progress * float64(time.Second)),
+		iteration: t.Iteration(),
+		total:     total,
+	}
+}
+
+// Elements returns the animations of the individual elements animated by the
+// timeline, if its behaviour animates elements.
+func (t *Timeline) Elements() []*ElementAnimation {
+	if eb, ok := t.tb.(interface {
+		Elements() []*ElementAnimation
+	}); ok {
+		return eb.Elements()
+	}
+
+	return nil
+}
+
+// Properties returns the properties animated for each element of the
+// timeline, if its behaviour reports them, see Claimable.
+func (t *Timeline) Properties() map[Elemental][]string {
+	if cl, ok := t.tb.(Claimable); ok {
+		return cl.Claims()
+	}
+
+	return nil
+}
+
 // loopRun calls the looping phase for the timeline.
 func (t *Timeline) loopRun() {
+	atomic.AddInt64(&t.iteration, 1)
 
 	// Pause and stop the current timer, we need a fresh timer
 	// to ensure our sequence end time checks works.
@@ -200,23 +736,33 @@ func (t *Timeline) loopRun() {
 
 	// Reset the behaviour for recall.
 	t.tb.Reset()
+	t.progress = 0
 
-	// Reset the reverse switches.
-	t.reversed = false
-	t.reversedDone = false
+	// Reset the reverse switches. Iterations playing in the opposite direction
+	// of the first iteration replay its rendered sequence backward.
+	t.reversed = t.stat.Backward(t.Iteration()) != t.stat.Backward(0)
 
-	// Create a new timer and run the clock.
-	t.timer = NewTimer(t, t.tmMod)
-	stopCache.Add(t.timer, engine.Loop(func(delta float64) {
-		t.timer.Update()
-	}, 0))
+	if t.reversed {
+		if rw, ok := t.tb.(TimelineBehaviourReplay); ok {
+			rw.Rewind()
+		}
+	}
+
+	// Create a new timer and run the clock, which waits out the delay between
+	// iterations in place of the initial delay.
+	t.tmMod.Delay = t.stat.LoopDelay
+	t.runTimer()
 
 	t.reclocking = true
+
+	if fb, ok := t.tb.(TimelineLoopEmitable); ok {
+		fb.EmitLoop(t.Iteration())
+	}
 }
 
 // Update implements the TimeBehaviour interface Update() function.
 func (t *Timeline) Update(delta float64, progress float64) {
-	if atomic.LoadInt64(&t.beating) < 1 {
+	if atomic.LoadInt64(&t.beating) < 1 || atomic.LoadInt64(&t.dead) > 0 {
 		return
 	}
 
@@ -236,19 +782,6 @@ func (t *Timeline) Update(delta float64, progress float64) {
 	t.progress = progress
 
 	if t.completed {
-		if t.stat.Reverse {
-
-			// If we have reversed and do not loop then end.
-			if t.reversed && t.reversedDone && !t.loops {
-				return
-			}
-
-			// If we have reversed and do loop but the loop is done then end.
-			if t.reversed && t.reversedDone && t.loops && atomic.LoadInt64(&t.loop) == 0 {
-				return
-			}
-		}
-
 		// If the loops and its not infinite and the loop is done then
 		// end.
 		if t.loops && !t.loopInfinite && atomic.LoadInt64(&t.loop) == 0 {
@@ -261,6 +794,11 @@ func (t *Timeline) Update(delta float64, progress float64) {
 	if t.timeline.Seconds() < progress || t.timeline.Seconds() < (progress+t.tmMod.MaxMSPerUpdate) {
 		if !t.completed {
 			t.completed = true
+
+			// The timeline completes before its last update reaches the end of
+			// the timeline, hence update and render its final state.
+			t.tb.Update(0, t.timeline.Seconds(), t.fraction(t.timeline.Seconds()))
+			t.tb.Render(0)
 			t.tb.Completed(0)
 
 			t.simulatedOnce.Do(func() {
@@ -281,43 +819,27 @@ func (t *Timeline) Update(delta float64, progress float64) {
 			}
 		}
 
-		if t.stat.Reverse {
-			if !t.reversed && !t.tb.Done() {
-				t.reversed = true
-			}
-
-			if t.reversed && !t.tb.Done() {
-				t.tb.UpdateReverse(delta)
-				return
-			}
-
-			t.reversedDone = true
-			t.tb.Reset()
-		}
-
+		// Infinite loops never reach their last iteration, hence keep looping
+		// without emitting their end.
 		if t.loops {
-			if t.loopInfinite {
-				t.endOnce.Do(func() {
-					atomic.StoreInt64(&t.dead, 1)
-					if fb, ok := t.tb.(TimelineEmitable); ok {
-						fb.EmitEnd(progress)
-					}
-				})
-
-				t.loopRun()
-				return
+			if !t.loopInfinite {
+				atomic.AddInt64(&t.loop, -1)
 			}
 
-			// fmt.Printf("Before looping Decrement: %d\n", atomic.LoadInt64(&t.loop))
-			atomic.AddInt64(&t.loop, -1)
-			// fmt.Printf("After looping Decrement: %d\n", atomic.LoadInt64(&t.loop))
-			if atomic.LoadInt64(&t.loop) > 0 {
+			if !t.stat.LastIteration(t.Iteration()) {
 				t.loopRun()
 				return
 			}
 		}
 
+		// Replays are timed against the clock, hence may end short of their
+		// last frame.
+		if rp, ok := t.tb.(TimelineBehaviourReplay); ok {
+			rp.Finish(t.reversed)
+		}
+
 		t.endOnce.Do(func() {
+			defer close(t.done)
 			atomic.StoreInt64(&t.dead, 1)
 			if fb, ok := t.tb.(TimelineEmitable); ok {
 				fb.EmitEnd(progress)
@@ -329,7 +851,74 @@ func (t *Timeline) Update(delta float64, progress float64) {
 		return
 	}
 
-	t.tb.Update(delta, progress, progress/t.timeline.Seconds())
+	t.tb.Update(delta, progress, t.fraction(progress))
+}
+
+// position returns the position(0..1) of the current progress within the
+// timeline, regardless of the direction of the iteration.
+func (t *Timeline) position() float64 {
+	if t.timeline <= 0 || t.progress >= t.timeline.Seconds() {
+		return 1
+	}
+
+	return t.progress / t.timeline.Seconds()
+}
+
+// fraction returns the position of the progress within the timeline, running
+// from 1 to 0 for iterations playing backward.
+func (t *Timeline) fraction(progress float64) float64 {
+	fraction := progress / t.timeline.Seconds()
+
+	if fraction > 1 {
+		fraction = 1
+	}
+
+	if t.stat.Backward(t.Iteration()) {
+		return 1 - fraction
+	}
+
+	return fraction
+}
+
+//==============================================================================
+
+// Frame defines a snapshot of the state of a timeline at a moment within its
+// current iteration.
+type Frame struct {
+	progress  float64
+	raw       float64
+	elapsed   time.Duration
+	iteration int
+	total     int
+}
+
+// Progress returns the position(0..1) within the iteration eased by the
+// easing of the timeline's stat, which may overshoot for springs and other
+// easings that do.
+func (f Frame) Progress() float64 {
+	return f.progress
+}
+
+// RawProgress returns the linear position(0..1) within the iteration, which
+// runs from 1 to 0 for iterations playing backward.
+func (f Frame) RawProgress() float64 {
+	return f.raw
+}
+
+// Elapsed returns the time elapsed within the iteration.
+func (f Frame) Elapsed() time.Duration {
+	return f.elapsed
+}
+
+// Iteration returns the current iteration, counted from zero.
+func (f Frame) Iteration() int {
+	return f.iteration
+}
+
+// TotalIterations returns the total iterations of the timeline, which is
+// negative for a timeline looping forever.
+func (f Frame) TotalIterations() int {
+	return f.total
 }
 
 //==============================================================================
@@ -350,10 +939,12 @@ type Timer interface {
 }
 
 // Timeable defines an interface that defines a Timer confirming
-// structure with the ability to set the TimeBehaviour to use.
+// structure with the ability to set the TimeBehaviour to use and the rate
+// at which it progresses.
 type Timeable interface {
 	Timer
 	Use(TimeBehaviour)
+	SetSpeed(float64)
 }
 
 // maxMSPerUpdate defines the maximum tick for which our updates
@@ -372,7 +963,7 @@ type ModeTimer struct {
 // NewTimer returns a new timer struct which calculates the delta and elapse time
 // each calls of run.
 func NewTimer(b TimeBehaviour, mod ModeTimer) Timeable {
-	tm := timer{behaviour: b, mode: mod}
+	tm := timer{behaviour: b, mode: mod, speed: math.Float64bits(1)}
 	return &tm
 }
 
@@ -404,6 +995,10 @@ type timer struct {
 
 	run      int64
 	stop     int64
+	resumed  int64
+	seeking  int64
+	seekTo   uint64
+	speed    uint64
 	skipTick float64
 }
 
@@ -415,6 +1010,13 @@ func (t *timer) Use(d TimeBehaviour) {
 	t.behaviour = d
 }
 
+// SetSpeed sets the rate at which the timer progresses, where 1 is the normal
+// rate and 0 stops the timer from progressing. The delay of the timer is not
+// affected by its speed.
+func (t *timer) SetSpeed(speed float64) {
+	atomic.StoreUint64(&t.speed, math.Float64bits(speed))
+}
+
 // Update updates the timers internal clocks, calculating the necessary durations
 // and delta values
 func (t *timer) Update() {
@@ -429,11 +1031,28 @@ func (t *timer) Update() {
 		t.init()
 	}
 
+	// Seeks are recorded by seek and applied here, sparing the listeners
+	// emitted within an update from taking the lock held by it.
+	if atomic.CompareAndSwapInt64(&t.seeking, 1, 0) {
+		if t.progress.Before(t.initial) {
+			t.progress = t.initial
+		}
+
+		t.totaldelta = math.Float64frombits(atomic.LoadUint64(&t.seekTo))
+		t.accumulator = 0
+	}
+
 	if atomic.LoadInt64(&t.stop) > 0 {
 		return
 	}
 
-	now := time.Now()
+	now := clockNow()
+
+	// Time spent paused is not part of the timer's progress, hence rebase the
+	// previous clock on the first tick after a resume.
+	if atomic.CompareAndSwapInt64(&t.resumed, 1, 0) {
+		t.previous = now
+	}
 
 	t.lastDelta = t.delta
 	t.delta = now.Sub(t.previous)
@@ -453,7 +1072,7 @@ func (t *timer) Update() {
 		dt = t.delta.Seconds()
 	}
 
-	t.accumulator += dt
+	t.accumulator += dt * math.Float64frombits(atomic.LoadUint64(&t.speed))
 
 	for t.accumulator >= t.mode.MaxMSPerUpdate {
 		t.behaviour.Update(t.mode.MaxMSPerUpdate, t.totaldelta)
@@ -471,14 +1090,17 @@ func (t *timer) Pause() {
 	atomic.StoreInt64(&t.stop, 1)
 }
 
-// Resume resets the timer loop as active.
+// Resume resets the timer loop as active, continuing from the progress it
+// was paused at, including any delay yet to elapse.
 func (t *timer) Resume() {
-	atomic.StoreInt64(&t.stop, 0)
+	if atomic.CompareAndSwapInt64(&t.stop, 1, 0) {
+		atomic.StoreInt64(&t.resumed, 1)
+	}
 }
 
 // init initializes the details of the time for work.
 func (t *timer) init() {
-	t.start = time.Now()
+	t.start = clockNow()
 	t.previous = t.start
 	t.progress = t.start
 	t.initial = t.start.Add(t.mode.Delay)
@@ -493,6 +1115,13 @@ func (t *timer) init() {
 	}
 }
 
+// seek moves the timer to the giving progress within its timeline on its next
+// update, ending any delay yet to elapse, where it continues from once resumed.
+func (t *timer) seek(progress float64) {
+	atomic.StoreUint64(&t.seekTo, math.Float64bits(progress))
+	atomic.StoreInt64(&t.seeking, 1)
+}
+
 // hasBegun returns true/false if the clock has begun running.
 func (t *timer) hasBegun() bool {
 	return atomic.LoadInt64(&t.run) > 0
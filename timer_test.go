@@ -2,10 +2,15 @@ package govfx_test
 
 import (
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/influx6/faux/loop"
 	"github.com/influx6/govfx"
+	"honnef.co/go/js/dom"
 )
 
 type mob struct{}
@@ -44,3 +49,879 @@ func TestTimer(t *testing.T) {
 		}
 	}
 }
+
+// TestStatLastIteration validates the behaviour of the Stat iteration
+// predicate for finite and infinite loops.
+func TestStatLastIteration(t *testing.T) {
+	once := govfx.Stat{}
+	if !once.LastIteration(0) {
+		t.Fatalf("Expected the first iteration of a non-looping stat to be its last")
+	}
+
+	twice := govfx.Stat{Loop: 2}
+	if twice.LastIteration(0) || !twice.LastIteration(1) {
+		t.Fatalf("Expected the second iteration of a stat looping twice to be its last")
+	}
+
+	forever := govfx.Stat{Loop: -1}
+	if !forever.Infinite() || forever.LastIteration(1000) {
+		t.Fatalf("Expected an infinite stat to never reach its last iteration")
+	}
+}
+
+// gear provides a loop.EngineGear which runs its registered loops only when
+// stepped, allowing tests to drive the animation loop.
+type gear struct {
+	ml    sync.Mutex
+	next  int
+	loops map[int]loop.Mux
+}
+
+type gearLooper struct {
+	g  *gear
+	id int
+}
+
+// End removes the loop from the gear.
+func (l gearLooper) End(...func()) {
+	l.g.ml.Lock()
+	defer l.g.ml.Unlock()
+	delete(l.g.loops, l.id)
+}
+
+// Loop registers the mux with the gear.
+func (g *gear) Loop(mx loop.Mux, queue int) loop.Looper {
+	g.ml.Lock()
+	defer g.ml.Unlock()
+
+	if g.loops == nil {
+		g.loops = make(map[int]loop.Mux)
+	}
+
+	g.next++
+	g.loops[g.next] = mx
+	return gearLooper{g: g, id: g.next}
+}
+
+// Run steps all registered loops every millisecond for the giving duration.
+func (g *gear) Run(d time.Duration) {
+	for end := time.Now().Add(d); time.Now().Before(end); {
+		g.Step()
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// Step runs all registered loops once.
+func (g *gear) Step() {
+	g.ml.Lock()
+	var muxs []loop.Mux
+	for _, mx := range g.loops {
+		muxs = append(muxs, mx)
+	}
+	g.ml.Unlock()
+
+	for _, mx := range muxs {
+		mx(0)
+	}
+}
+
+// TestTimelineStop validates the behaviour of stopping a running infinite
+// timeline.
+func TestTimelineStop(t *testing.T) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	var progress, ends, cancels int
+
+	timeline := govfx.Animate(govfx.Stat{
+		Duration: 50 * time.Millisecond,
+		Loop:     -1,
+		End:      govfx.NewListener(func(float64) { ends++ }),
+		Cancel:   govfx.NewListener(func(float64) { cancels++ }),
+		Progress: govfx.NewListener(func(float64) { progress++ }),
+	}, nil, nil)
+
+	timeline.Start()
+	g.Run(100 * time.Millisecond)
+
+	if progress == 0 {
+		t.Fatalf("Expected the timeline to have progressed before stopping")
+	}
+
+	timeline.Stop()
+	stopped := progress
+
+	g.Run(100 * time.Millisecond)
+
+	if progress != stopped {
+		t.Fatalf("Expected no progress after stopping but got %d more", progress-stopped)
+	}
+
+	if ends != 0 {
+		t.Fatalf("Expected no end to be emitted when stopped but got %d", ends)
+	}
+
+	if cancels != 1 {
+		t.Fatalf("Expected cancel to be emitted once but got %d", cancels)
+	}
+}
+
+// TestTimelinePauseResume validates the behaviour of the pause and resume
+// listeners, which fire only when the timeline changes state.
+func TestTimelinePauseResume(t *testing.T) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	var pauses, resumes int
+
+	timeline := govfx.Animate(govfx.Stat{
+		Duration: 50 * time.Millisecond,
+		Loop:     -1,
+		Pause:    govfx.NewListener(func(float64) { pauses++ }),
+		Resume:   govfx.NewListener(func(float64) { resumes++ }),
+	}, nil, nil)
+
+	timeline.Resume()
+	timeline.Start()
+	g.Run(20 * time.Millisecond)
+
+	timeline.Pause()
+	timeline.Pause()
+	g.Run(20 * time.Millisecond)
+
+	timeline.Resume()
+	timeline.Resume()
+	g.Run(20 * time.Millisecond)
+
+	timeline.Stop()
+	timeline.Pause()
+
+	if pauses != 1 {
+		t.Fatalf("Expected pause to be emitted once but got %d", pauses)
+	}
+
+	if resumes != 1 {
+		t.Fatalf("Expected resume to be emitted once but got %d", resumes)
+	}
+}
+
+// TestTimelineMaxFPS validates the behaviour of capping the frame rate of a
+// timeline ticked faster than its cap.
+func TestTimelineMaxFPS(t *testing.T) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	var frames int
+
+	timeline := govfx.Animate(govfx.Stat{
+		Duration: time.Second,
+		MaxFPS:   50,
+		Progress: govfx.NewListener(func(float64) { frames++ }),
+	}, nil, nil)
+
+	start := time.Now()
+
+	timeline.Start()
+	g.Run(200 * time.Millisecond)
+
+	elapsed := time.Since(start)
+
+	if frames == 0 {
+		t.Fatalf("Expected the timeline to have rendered frames")
+	}
+
+	if max := int(elapsed.Seconds()*50) + 1; frames > max {
+		t.Fatalf("Expected at most %d frames within %s but got %d", max, elapsed, frames)
+	}
+
+	if progress := timeline.Frame().Elapsed(); progress < 150*time.Millisecond {
+		t.Fatalf("Expected the timeline to progress with the elapsed time but got %s", progress)
+	}
+}
+
+// TestTimelineDone validates the behaviour of the done channel of timelines
+// which end naturally or are stopped.
+func TestTimelineDone(t *testing.T) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	ending := govfx.Animate(govfx.Stat{Duration: 20 * time.Millisecond}, nil, nil)
+	stopping := govfx.Animate(govfx.Stat{Duration: time.Hour}, nil, nil)
+
+	if ending.Done() != ending.Done() {
+		t.Fatalf("Expected the same done channel on every call")
+	}
+
+	ending.Start()
+	stopping.Start()
+
+	g.Run(60 * time.Millisecond)
+	stopping.Stop()
+
+	for _, timeline := range []*govfx.Timeline{ending, stopping} {
+		select {
+		case <-timeline.Done():
+		default:
+			t.Fatalf("Expected the done channel to be closed")
+		}
+
+		timeline.Wait()
+	}
+}
+
+// TestTimelineDoneUnstarted validates the behaviour of the done channel of
+// timelines stopped before their start or refused by Start.
+func TestTimelineDoneUnstarted(t *testing.T) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	var elem fakeElem
+
+	running := govfx.Animate(govfx.Stat{Duration: time.Hour}, nil, govfx.Elementals{&elem})
+	running.Start()
+	defer running.Stop()
+
+	stopped := govfx.Animate(govfx.Stat{Duration: time.Hour}, nil, nil)
+	stopped.Stop()
+
+	malformed := govfx.Animate(govfx.Stat{Duration: time.Hour, Easing: "no-such-easing"}, nil, nil)
+	if err := malformed.Start(); err == nil {
+		t.Fatalf("Expected a timeline with an unknown easing to fail to start")
+	}
+
+	ignored := govfx.Animate(govfx.Stat{Duration: time.Hour, OnConflict: govfx.Ignore}, nil, govfx.Elementals{&elem})
+	if err := ignored.Start(); err != govfx.ErrConflict {
+		t.Fatalf("Expected an ignored conflict to be rejected but got %v", err)
+	}
+
+	for _, timeline := range []*govfx.Timeline{stopped, malformed, ignored} {
+		select {
+		case <-timeline.Done():
+		default:
+			t.Fatalf("Expected the done channel to be closed")
+		}
+
+		if state := timeline.State(); state != govfx.Cancelled {
+			t.Fatalf("Expected the timeline to be cancelled but got %d", state)
+		}
+	}
+
+	stopped.Start()
+	g.Step()
+
+	if state := stopped.State(); state != govfx.Cancelled {
+		t.Fatalf("Expected a stopped timeline not to start again but got %d", state)
+	}
+}
+
+// TestTimelineState validates the behaviour of the state of a timeline through
+// its delay, pause, completion and cancellation.
+func TestTimelineState(t *testing.T) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	ending := govfx.Animate(govfx.Stat{Duration: 20 * time.Millisecond, Delay: 20 * time.Millisecond}, nil, nil)
+	stopping := govfx.Animate(govfx.Stat{Duration: time.Hour}, nil, nil)
+
+	if state := ending.State(); state != govfx.Idle {
+		t.Fatalf("Expected a timeline not started to be idle but got %d", state)
+	}
+
+	ending.Start()
+	stopping.Start()
+
+	if !ending.IsRunning() {
+		t.Fatalf("Expected a timeline within its delay to be running but got %d", ending.State())
+	}
+
+	stopping.Pause()
+	if state := stopping.State(); state != govfx.Paused {
+		t.Fatalf("Expected a paused timeline to be paused but got %d", state)
+	}
+
+	stopping.Resume()
+	g.Run(80 * time.Millisecond)
+	stopping.Stop()
+
+	if state := ending.State(); state != govfx.Completed {
+		t.Fatalf("Expected an ended timeline to be completed but got %d", state)
+	}
+
+	if state := stopping.State(); state != govfx.Cancelled {
+		t.Fatalf("Expected a stopped timeline to be cancelled but got %d", state)
+	}
+}
+
+// TestTimelineConflicts validates the behaviour of the conflict policies of
+// timelines animating the same properties of an element.
+func TestTimelineConflicts(t *testing.T) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	expected := map[govfx.ConflictPolicy][2]govfx.AnimationState{
+		govfx.Replace: {govfx.Cancelled, govfx.Running},
+		govfx.Ignore:  {govfx.Running, govfx.Cancelled},
+		govfx.Enqueue: {govfx.Running, govfx.Running},
+	}
+
+	for policy, states := range expected {
+		var elem fakeElem
+
+		running := govfx.Animate(govfx.Stat{Duration: 30 * time.Millisecond}, nil, govfx.Elementals{&elem})
+		starting := govfx.Animate(govfx.Stat{Duration: 30 * time.Millisecond, OnConflict: policy}, nil, govfx.Elementals{&elem})
+
+		running.Start()
+		err := starting.Start()
+
+		if (err == govfx.ErrConflict) != (policy == govfx.Ignore) {
+			t.Fatalf("Expected only an ignored conflict to be rejected but got %v for %d", err, policy)
+		}
+
+		if running.State() != states[0] || starting.State() != states[1] {
+			t.Fatalf("Expected the states %v for %d but got %d and %d", states, policy, running.State(), starting.State())
+		}
+
+		g.Run(100 * time.Millisecond)
+
+		if policy == govfx.Enqueue && starting.State() != govfx.Completed {
+			t.Fatalf("Expected the enqueued timeline to run once the other ended but got %d", starting.State())
+		}
+	}
+
+	var elem fakeElem
+
+	running := govfx.Animate(govfx.Stat{Duration: 30 * time.Millisecond}, nil, govfx.Elementals{&elem})
+	waiting := govfx.Animate(govfx.Stat{Duration: 30 * time.Millisecond, OnConflict: govfx.Enqueue}, nil, govfx.Elementals{&elem})
+
+	running.Start()
+	waiting.Start()
+	waiting.Stop()
+
+	g.Run(100 * time.Millisecond)
+
+	if waiting.State() != govfx.Cancelled {
+		t.Fatalf("Expected the enqueued timeline stopped while waiting to be cancelled but got %d", waiting.State())
+	}
+}
+
+// TestNativeTimeline validates the behaviour of timelines handed to native
+// css transitions, and of those falling back to the engine loop.
+func TestNativeTimeline(t *testing.T) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	govfx.SetStyleProvider(cannedStyles("width: 0px;"))
+	defer govfx.SetStyleProvider(nil)
+
+	elem := &fakeElem{Elemental: govfx.NewElement(nil, "")}
+
+	ended := make(chan struct{})
+	stat := govfx.Stat{
+		Duration: 20 * time.Millisecond,
+		Easing:   "linear",
+		Native:   true,
+		End: govfx.NewListener(func(float64) {
+			close(ended)
+		}),
+	}
+
+	timeline := govfx.Animate(stat, govfx.Values{{"animate": "width", "value": 100}}, govfx.Elementals{elem})
+	timeline.Start()
+
+	if expected := "width: 100px; transition: width 0.020s cubic-bezier(0, 0, 1, 1) 0.000s;"; elem.style != expected {
+		t.Fatalf("Expected the native timeline to transition to %q but got %q", expected, elem.style)
+	}
+
+	// The end of the transition is handled within the frames of the loop.
+	deadline := time.Now().Add(time.Second)
+
+	for waiting := true; waiting; {
+		select {
+		case <-ended:
+			waiting = false
+		case <-time.After(time.Millisecond):
+			if time.Now().After(deadline) {
+				t.Fatalf("Expected the native timeline to end once its transition ends")
+			}
+
+			g.Step()
+		}
+	}
+
+	if elem.style != "width: 100px;" || timeline.State() != govfx.Completed {
+		t.Fatalf("Expected the ended native timeline to drop its transition but got %q", elem.style)
+	}
+
+	bouncing := &fakeElem{Elemental: govfx.NewElement(nil, "")}
+
+	stat.Easing, stat.End = "easeOutBounce", nil
+	govfx.Animate(stat, govfx.Values{{"animate": "width", "value": 100}}, govfx.Elementals{bouncing}).Start()
+
+	if strings.Contains(bouncing.style, "transition") {
+		t.Fatalf("Expected a easing without a css equivalent to run on the engine loop but got %q", bouncing.style)
+	}
+}
+
+// TestActiveAnimations validates the behaviour of the registry of the started
+// animations which have not yet ended.
+func TestActiveAnimations(t *testing.T) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	govfx.SetStyleProvider(cannedStyles("opacity: 0;"))
+	defer govfx.SetStyleProvider(nil)
+
+	govfx.StopAll()
+
+	elem := &fakeElem{Elemental: govfx.NewElement(nil, "")}
+
+	running := govfx.Animate(govfx.Stat{Duration: time.Hour}, govfx.Values{{"animate": "opacity", "value": 1.0}}, govfx.Elementals{elem})
+	idle := govfx.Animate(govfx.Stat{Duration: time.Hour}, nil, nil)
+
+	running.Start()
+
+	animations := govfx.ActiveAnimations()
+	if len(animations) != 1 || animations[0] != govfx.Animation(running) {
+		t.Fatalf("Expected only the started animation to be active but got %d animations", len(animations))
+	}
+
+	if props := animations[0].Properties()[elem]; len(props) != 1 || props[0] != "opacity" {
+		t.Fatalf("Expected the active animation to report its opacity but got %v", props)
+	}
+
+	govfx.PauseAll()
+	if state := running.State(); state != govfx.Paused {
+		t.Fatalf("Expected PauseAll to pause the active animation but got %d", state)
+	}
+
+	govfx.ResumeAll()
+	govfx.StopAll()
+
+	if state := running.State(); state != govfx.Cancelled || idle.State() != govfx.Idle {
+		t.Fatalf("Expected StopAll to stop only the active animation but got %d and %d", state, idle.State())
+	}
+
+	if animations := govfx.ActiveAnimations(); len(animations) != 0 {
+		t.Fatalf("Expected no active animations after StopAll but got %d", len(animations))
+	}
+}
+
+// fakeClock provides a govfx.Clock which only advances when told to.
+type fakeClock struct {
+	now time.Duration
+}
+
+// Now returns the current time of the clock.
+func (c *fakeClock) Now() time.Duration {
+	return c.now
+}
+
+// TestClock validates the behaviour of timelines timed by an injected clock,
+// which renders the same frames for the same steps of the clock. The frames
+// trail the clock by the fixed update step of the timer of 10ms.
+func TestClock(t *testing.T) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	govfx.SetStyleProvider(cannedStyles("width: 0px;"))
+	defer govfx.SetStyleProvider(nil)
+
+	clock := &fakeClock{}
+	govfx.SetClock(clock)
+	defer govfx.SetClock(nil)
+
+	elem := &fakeElem{Elemental: govfx.NewElement(nil, "")}
+
+	timeline := govfx.Animate(govfx.Stat{Duration: 40 * time.Millisecond, Easing: "linear"}, govfx.Values{{"animate": "width", "value": 100}}, govfx.Elementals{elem})
+	timeline.Start()
+
+	frames := []struct {
+		progress float64
+		style    string
+	}{
+		{0, "width: 0px;"},
+		{0, "width: 0px;"},
+		{0.25, "width: 25px;"},
+		{0.5, "width: 50px;"},
+		{0.75, "width: 75px;"},
+		{1, "width: 100px;"},
+	}
+
+	for step, frame := range frames {
+		g.Step()
+
+		if progress := timeline.Frame().Progress(); progress != frame.progress || elem.style != frame.style {
+			t.Fatalf("Expected frame %d to be at %.2f with %q but got %.2f with %q", step, frame.progress, frame.style, progress, elem.style)
+		}
+
+		clock.now += 10 * time.Millisecond
+	}
+
+	if state := timeline.State(); state != govfx.Completed {
+		t.Fatalf("Expected the timeline to complete with its last frame but got %d", state)
+	}
+}
+
+// TestTimelineStep validates the behaviour of stepping a timeline by hand,
+// forward and backward, before resuming its automatic run.
+func TestTimelineStep(t *testing.T) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	govfx.SetStyleProvider(cannedStyles("width: 0px;"))
+	defer govfx.SetStyleProvider(nil)
+
+	elem := &fakeElem{Elemental: govfx.NewElement(nil, "")}
+
+	var progress int
+	var ended bool
+
+	timeline := govfx.Animate(govfx.Stat{
+		Duration: 40 * time.Millisecond,
+		Easing:   "linear",
+		Progress: govfx.NewListener(func(float64) { progress++ }),
+		End:      govfx.NewListener(func(float64) { ended = true }),
+	}, govfx.Values{{"animate": "width", "value": 100}}, govfx.Elementals{elem})
+
+	steps := []struct {
+		dt    time.Duration
+		style string
+	}{
+		{10 * time.Millisecond, "width: 25px;"},
+		{20 * time.Millisecond, "width: 75px;"},
+		{-10 * time.Millisecond, "width: 50px;"},
+		{-time.Second, "width: 0px;"},
+	}
+
+	for _, step := range steps {
+		timeline.Step(step.dt)
+		g.Step()
+
+		if elem.style != step.style {
+			t.Fatalf("Expected stepping by %s to render %q but got %q", step.dt, step.style, elem.style)
+		}
+	}
+
+	if state := timeline.State(); state != govfx.Paused || progress != len(steps) {
+		t.Fatalf("Expected the stepped timeline to be paused with %d progress signals but got %d with %d", len(steps), state, progress)
+	}
+
+	timeline.Step(time.Second)
+	timeline.Resume()
+	g.Run(50 * time.Millisecond)
+
+	if !ended || elem.style != "width: 100px;" {
+		t.Fatalf("Expected the resumed timeline to end at its last frame but got %q", elem.style)
+	}
+}
+
+// TestTimelineSeek validates the behaviour of seeking a timeline to positions
+// eased by its sequences, without emitting its lifecycle signals.
+func TestTimelineSeek(t *testing.T) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	govfx.SetStyleProvider(cannedStyles("opacity: 0;"))
+	defer govfx.SetStyleProvider(nil)
+
+	elem := &fakeElem{Elemental: govfx.NewElement(nil, "")}
+
+	var ends int
+
+	timeline := govfx.Animate(govfx.Stat{
+		Duration: 40 * time.Millisecond,
+		Easing:   "ease-out",
+		End:      govfx.NewListener(func(float64) { ends++ }),
+	}, govfx.Values{{"animate": "opacity", "value": 1.0}}, govfx.Elementals{elem})
+
+	ease := govfx.GetEasing("ease-out")
+
+	for _, position := range []float64{0.5, 0.25, 1, 0} {
+		timeline.Seek(position)
+
+		if expected := fmt.Sprintf("opacity: %.2f;", ease.Ease(position)); elem.style != expected {
+			t.Fatalf("Expected seeking to %.2f to render %q but got %q", position, expected, elem.style)
+		}
+	}
+
+	timeline.Seek(1)
+	g.Step()
+
+	if ends != 0 || timeline.State() != govfx.Paused {
+		t.Fatalf("Expected seeking to the end to leave the timeline paused without ending it")
+	}
+
+	timeline.Resume()
+	g.Run(20 * time.Millisecond)
+
+	if ends != 1 {
+		t.Fatalf("Expected the timeline resumed from its end to end once but got %d ends", ends)
+	}
+}
+
+// TestTimelineSeekWithinProgress validates the behaviour of seeking a timeline
+// from within its progress listener, which must not stall the loop.
+func TestTimelineSeekWithinProgress(t *testing.T) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	govfx.SetStyleProvider(cannedStyles("opacity: 0;"))
+	defer govfx.SetStyleProvider(nil)
+
+	elem := &fakeElem{Elemental: govfx.NewElement(nil, "")}
+
+	var timeline *govfx.Timeline
+	var seeked bool
+
+	timeline = govfx.Animate(govfx.Stat{
+		Duration: 40 * time.Millisecond,
+		Easing:   "linear",
+		Progress: govfx.NewListener(func(float64) {
+			if !seeked {
+				seeked = true
+				timeline.Seek(0.5)
+			}
+		}),
+	}, govfx.Values{{"animate": "opacity", "value": 1.0}}, govfx.Elementals{elem})
+
+	done := make(chan struct{})
+
+	go func() {
+		timeline.Start()
+		g.Run(20 * time.Millisecond)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("Expected seeking within the progress listener not to stall the loop")
+	}
+
+	if !seeked || timeline.State() != govfx.Paused || elem.style != "opacity: 0.50;" {
+		t.Fatalf("Expected the timeline to be paused at its seeked position but got %q", elem.style)
+	}
+}
+
+// TestAnimateAll validates the behaviour of batches, which start timelines
+// together and end once all of them end.
+func TestAnimateAll(t *testing.T) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	govfx.SetStyleProvider(cannedStyles("width: 0px; opacity: 0;"))
+	defer govfx.SetStyleProvider(nil)
+
+	clock := &fakeClock{}
+	govfx.SetClock(clock)
+	defer govfx.SetClock(nil)
+
+	elem := &fakeElem{Elemental: govfx.NewElement(nil, "")}
+
+	batch := govfx.AnimateAll(
+		govfx.Animate(govfx.Stat{Duration: 20 * time.Millisecond, Easing: "linear"}, govfx.Values{{"animate": "width", "value": 100}}, govfx.Elementals{elem}),
+		govfx.Animate(govfx.Stat{Duration: 40 * time.Millisecond, Easing: "linear"}, govfx.Values{{"animate": "opacity", "value": 1.0}}, nil),
+	)
+
+	var progress []float64
+	var began, ended int
+
+	batch.Begin = govfx.NewListener(func(float64) { began++ })
+	batch.End = govfx.NewListener(func(float64) { ended++ })
+	batch.Progress = govfx.NewListener(func(at float64) { progress = append(progress, at) })
+
+	if err := batch.Start(); err != nil {
+		t.Fatalf("Expected the batch to start: %s", err)
+	}
+
+	for step := 0; step < 7; step++ {
+		g.Step()
+		clock.now += 10 * time.Millisecond
+	}
+
+	if began != 1 || ended != 1 || batch.State() != govfx.Completed {
+		t.Fatalf("Expected the batch to begin and end once but got %d, %d with state %d", began, ended, batch.State())
+	}
+
+	for index := 1; index < len(progress); index++ {
+		if progress[index] < progress[index-1] {
+			t.Fatalf("Expected the progress of the batch to follow its slowest timeline but got %v", progress)
+		}
+	}
+
+	if last := progress[len(progress)-1]; last != 1 || progress[2] != 0.25 {
+		t.Fatalf("Expected the progress of the batch to follow its slowest timeline but got %v", progress)
+	}
+}
+
+// TestAnimateAllConflicts validates the behaviour of batches whose timelines
+// conflict with running timelines, which begin once all of them began.
+func TestAnimateAllConflicts(t *testing.T) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	var elem fakeElem
+
+	running := govfx.Animate(govfx.Stat{Duration: 30 * time.Millisecond}, nil, govfx.Elementals{&elem})
+	running.Start()
+
+	batch := govfx.AnimateAll(govfx.Animate(govfx.Stat{Duration: 30 * time.Millisecond, OnConflict: govfx.Enqueue}, nil, govfx.Elementals{&elem}))
+
+	var began int
+	batch.Begin = govfx.NewListener(func(float64) { began++ })
+
+	if err := batch.Start(); err != nil {
+		t.Fatalf("Expected the enqueued batch to start: %s", err)
+	}
+
+	g.Step()
+
+	if began != 0 {
+		t.Fatal("Expected the batch to begin only once its enqueued timeline began")
+	}
+
+	g.Run(100 * time.Millisecond)
+
+	if began != 1 {
+		t.Fatalf("Expected the batch to begin once its enqueued timeline began but got %d begins", began)
+	}
+
+	govfx.StopAll()
+
+	running = govfx.Animate(govfx.Stat{Duration: time.Hour}, nil, govfx.Elementals{&elem})
+	running.Start()
+	defer running.Stop()
+
+	free := govfx.Animate(govfx.Stat{Duration: time.Hour}, nil, nil)
+	batch = govfx.AnimateAll(free, govfx.Animate(govfx.Stat{Duration: time.Hour, OnConflict: govfx.Ignore}, nil, govfx.Elementals{&elem}))
+
+	if err := batch.Start(); err != govfx.ErrConflict {
+		t.Fatalf("Expected the batch to be rejected with its ignored timeline but got %v", err)
+	}
+
+	if free.State() != govfx.Cancelled {
+		t.Fatalf("Expected the timelines started before the rejected one to be stopped but got %d", free.State())
+	}
+}
+
+// TestReducedMotionStop validates the behaviour of stopping timelines which
+// finished instantly under reduced motion, which never run a timer.
+func TestReducedMotionStop(t *testing.T) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	govfx.SetStyleProvider(cannedStyles("width: 0px;"))
+	defer govfx.SetStyleProvider(nil)
+
+	govfx.RespectReducedMotion(true)
+	govfx.SetReducedMotionPreference(func() bool { return true })
+	defer govfx.RespectReducedMotion(false)
+	defer govfx.SetReducedMotionPreference(nil)
+
+	elem := &fakeElem{Elemental: govfx.NewElement(nil, "")}
+
+	timeline := govfx.Animate(govfx.Stat{Duration: time.Second}, govfx.Values{{"animate": "width", "value": 100}}, govfx.Elementals{elem})
+	timeline.Start()
+
+	if state := timeline.State(); state != govfx.Completed || elem.style != "width: 100px;" {
+		t.Fatalf("Expected the timeline to finish instantly but got %d with %q", state, elem.style)
+	}
+
+	timeline.Stop()
+	govfx.StopAll()
+
+	if state := timeline.State(); state != govfx.Completed {
+		t.Fatalf("Expected stopping a finished timeline to keep it completed but got %d", state)
+	}
+}
+
+// domElem provides a dom.Element outside of the dom, whose style attribute is
+// kept in memory.
+type domElem struct {
+	dom.Element
+	object js.Object
+	style  string
+}
+
+func (e *domElem) Underlying() *js.Object                 { return &e.object }
+func (e *domElem) GetAttribute(string) string             { return e.style }
+func (e *domElem) SetAttribute(name string, value string) { e.style = value }
+
+// TestElementQueue validates the behaviour of element queues, which run their
+// animations one after the other, skipping those failing to start.
+func TestElementQueue(t *testing.T) {
+	var g gear
+	govfx.Init(g.Loop)
+
+	govfx.SetStyleProvider(cannedStyles("width: 0px; opacity: 0;"))
+	defer govfx.SetStyleProvider(nil)
+
+	var ended []string
+
+	end := func(name string) govfx.Listener {
+		return govfx.NewListener(func(float64) { ended = append(ended, name) })
+	}
+
+	quick := 20 * time.Millisecond
+
+	queue := govfx.Queue(&domElem{})
+	queue.Add(govfx.Stat{Duration: quick, End: end("width")}, govfx.Value{"animate": "width", "value": 100})
+	queue.Add(govfx.Stat{Duration: quick, Easing: "cubic-bezier(1)", End: end("invalid")}, govfx.Value{"animate": "width", "value": 50})
+	queue.Add(govfx.Stat{Duration: quick, End: end("opacity")}, govfx.Value{"animate": "opacity", "value": 1.0})
+
+	if err := queue.Play(); err != nil {
+		t.Fatalf("Expected the queue to play: %s", err)
+	}
+
+	g.Run(200 * time.Millisecond)
+
+	if strings.Join(ended, " ") != "width opacity" || queue.Current() != nil {
+		t.Fatalf("Expected the animations to end in order, skipping the invalid one, but got %v", ended)
+	}
+
+	// A failing first animation is reported while the queue moves on.
+	ended = nil
+
+	queue.Add(govfx.Stat{Duration: quick, Easing: "cubic-bezier(1)"}, govfx.Value{"animate": "width", "value": 50})
+	queue.Add(govfx.Stat{Duration: quick, End: end("width")}, govfx.Value{"animate": "width", "value": 100})
+
+	if err := queue.Play(); err == nil {
+		t.Fatal("Expected the invalid first animation to fail the play")
+	}
+
+	g.Run(200 * time.Millisecond)
+
+	if strings.Join(ended, " ") != "width" {
+		t.Fatalf("Expected the queue to move on past the invalid animation but got %v", ended)
+	}
+
+	// Clear lets the running animation end, dropping the waiting ones.
+	ended = nil
+
+	queue.Add(govfx.Stat{Duration: quick, End: end("width")}, govfx.Value{"animate": "width", "value": 100})
+	queue.Add(govfx.Stat{Duration: quick, End: end("opacity")}, govfx.Value{"animate": "opacity", "value": 1.0})
+	queue.Play()
+	queue.Clear()
+
+	g.Run(200 * time.Millisecond)
+
+	if strings.Join(ended, " ") != "width" {
+		t.Fatalf("Expected only the running animation to end once cleared but got %v", ended)
+	}
+
+	// Stop cancels the running animation, dropping the waiting ones.
+	ended = nil
+
+	queue.Add(govfx.Stat{Duration: time.Hour, End: end("width")}, govfx.Value{"animate": "width", "value": 100})
+	queue.Add(govfx.Stat{Duration: quick, End: end("opacity")}, govfx.Value{"animate": "opacity", "value": 1.0})
+	queue.Play()
+
+	running := queue.Current()
+	queue.Stop()
+
+	g.Run(100 * time.Millisecond)
+
+	if len(ended) != 0 || running.State() != govfx.Cancelled || queue.Current() != nil {
+		t.Fatalf("Expected the running animation to be cancelled and the queue emptied but got %v with %d", ended, running.State())
+	}
+}
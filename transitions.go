@@ -0,0 +1,277 @@
+package govfx
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/influx6/faux/loop"
+	"honnef.co/go/js/dom"
+)
+
+//==============================================================================
+
+// Transitionable returns true/false if the sequence can be handed to native
+// css transitions, being when the sequences of its elements are
+// Transitionable, none of its values sets its own easing and the easing of its
+// stat is a cubic-bezier or steps.
+func (f *SeqBev) Transitionable() bool {
+	if _, ok := transitionTiming(f.Stat.Easing); !ok {
+		return false
+	}
+
+	for _, idea := range f.ideas {
+		if _, ok := idea["easer"]; ok {
+			return false
+		}
+
+		if easing, ok := idea["easing"].(string); ok && easing != f.Stat.Easing {
+			return false
+		}
+	}
+
+	for _, elem := range f.elems {
+		if tem, ok := elem.(Transitionable); ok && !tem.Transitionable() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Transition writes the start styles of the elements, then their target
+// styles along the transitions taking them there, calling ended once the
+// transitions of all elements end.
+func (f *SeqBev) Transition(speed float64, ended func()) {
+	timing, _ := transitionTiming(f.Stat.Easing)
+	duration := time.Duration(float64(f.Stat.duration()) / speed)
+
+	f.nl.Lock()
+	f.nativeSpeed = speed
+	f.nativeDone = false
+	f.targets = make([]string, len(f.elems))
+	f.waits = nil
+	f.nl.Unlock()
+
+	if len(f.elems) == 0 {
+		ended()
+		return
+	}
+
+	pending := int64(len(f.elems))
+	finish := func() {
+		if atomic.AddInt64(&pending, -1) == 0 {
+			f.endTransition(ended)
+		}
+	}
+
+	for index, elem := range f.elems {
+		elem.Update(0, 0)
+		start := elementCSS(elem)
+
+		elem.Update(0, 1)
+		target := elementCSS(elem)
+
+		f.targets[index] = target
+
+		// Elements whose styles do not change emit no transitionend.
+		if start == target {
+			finish()
+			continue
+		}
+
+		delay := f.elements[index].Stat.Delay
+
+		var transitions []string
+		for _, prop := range f.props[index] {
+			transitions = append(transitions, fmt.Sprintf("%s %.3fs %s %.3fs", prop, duration.Seconds(), timing, delay.Seconds()))
+		}
+
+		// The styles are written straight to the element, as coalescing them
+		// within a frame would skip the start styles the transition runs from.
+		elem.SetAttribute("style", start)
+		reflow(elem)
+		elem.SetAttribute("style", target+" transition: "+strings.Join(transitions, ", ")+";")
+
+		wait := onTransitionEnd(elem, delay+duration, finish)
+
+		f.nl.Lock()
+		f.waits = append(f.waits, wait)
+		f.nl.Unlock()
+	}
+}
+
+// StopTransition freezes the elements at the styles of the time elapsed since
+// their transitions were set, removing their transitions.
+func (f *SeqBev) StopTransition(elapsed time.Duration) {
+	f.nl.Lock()
+	if f.nativeDone {
+		f.nl.Unlock()
+		return
+	}
+
+	f.nativeDone = true
+	speed, waits := f.nativeSpeed, f.waits
+	f.nl.Unlock()
+
+	for _, wait := range waits {
+		wait()
+	}
+
+	duration := f.Stat.duration().Seconds()
+
+	for index, elem := range f.elems {
+		local := 1.0
+		if duration > 0 {
+			local = (elapsed - f.elements[index].Stat.Delay).Seconds() * speed / duration
+		}
+
+		if local < 0 {
+			local = 0
+		}
+
+		if local > 1 {
+			local = 1
+		}
+
+		elem.Update(0, local)
+		scheduler.Write(Block{Elem: elem, Buf: bytes.NewBufferString(elementCSS(elem))})
+	}
+}
+
+// endTransition writes the final styles of the elements without their
+// transitions, which are the styles from before the sequence when its fill
+// mode is none or backwards, then calls ended.
+func (f *SeqBev) endTransition(ended func()) {
+	f.nl.Lock()
+	if f.nativeDone {
+		f.nl.Unlock()
+		return
+	}
+
+	f.nativeDone = true
+	f.nl.Unlock()
+
+	for index, elem := range f.elems {
+		style := f.targets[index]
+		if f.Stat.fill() == FillNone || f.Stat.fill() == FillBackwards {
+			style = f.styles[index]
+		}
+
+		scheduler.Write(Block{Elem: elem, Buf: bytes.NewBufferString(style)})
+	}
+
+	ended()
+}
+
+//==============================================================================
+
+// transitionTiming returns the css transition-timing-function matching the
+// easing, which only exists for the cubic-bezier and steps easings.
+func transitionTiming(easing string) (string, bool) {
+	es, err := ParseEasing(easing)
+	if err != nil {
+		return "", false
+	}
+
+	switch ease := es.(type) {
+	case *Spline:
+		return fmt.Sprintf("cubic-bezier(%g, %g, %g, %g)", ease.x1, ease.y1, ease.x2, ease.y2), true
+	case Steps:
+		return fmt.Sprintf("steps(%d, %s)", ease.Count, ease.Jump), true
+	}
+
+	return "", false
+}
+
+// elementCSS returns the css output of the element.
+func elementCSS(elem Elemental) string {
+	var buf bytes.Buffer
+	elem.CSS(&buf)
+	return buf.String()
+}
+
+// reflow forces the browser to compute the styles of the element, hence the
+// styles written afterwards transition from the styles written before.
+func reflow(elem Elemental) {
+	if em, ok := elem.(*Element); ok && em.Element != nil {
+		em.Underlying().Get("offsetWidth")
+	}
+}
+
+// transitionGrace defines how long past the end of its transitions the
+// transitionend of an element is awaited.
+const transitionGrace = 100 * time.Millisecond
+
+// onTransitionEnd calls the function once the transitions of the element end,
+// being its first own transitionend event, else once the giving time passes,
+// which also covers elements outside of the dom and properties the browser
+// does not transition, which emit no transitionend. The timer falls back to
+// the next frame of the engine loop, hence the function never writes styles
+// from the timer. Returns a function dropping the wait.
+func onTransitionEnd(elem Elemental, after time.Duration, fn func()) func() {
+	var once sync.Once
+
+	em, ok := elem.(*Element)
+	if !ok || em.Element == nil {
+		timer := time.AfterFunc(after, func() {
+			nextFrame(func() { once.Do(fn) })
+		})
+
+		return func() { timer.Stop() }
+	}
+
+	// The transitionend of the element is awaited a little past the end of
+	// its transitions before falling back to the timer.
+	timer := time.AfterFunc(after+transitionGrace, func() {
+		nextFrame(func() { once.Do(fn) })
+	})
+
+	var listener func(*js.Object)
+
+	listener = em.AddEventListener("transitionend", false, func(ev dom.Event) {
+		// Transitions of the children of the element bubble up to it.
+		if ev.Target().Underlying() != em.Underlying() {
+			return
+		}
+
+		once.Do(func() {
+			timer.Stop()
+			em.RemoveEventListener("transitionend", false, listener)
+			fn()
+		})
+	})
+
+	return func() {
+		timer.Stop()
+		em.RemoveEventListener("transitionend", false, listener)
+	}
+}
+
+// nextFrame runs the function within the next frame of the engine loop, where
+// the styles it writes are written alongside those of the frame.
+func nextFrame(fn func()) {
+	var ml sync.Mutex
+	var once sync.Once
+	var looper loop.Looper
+
+	ml.Lock()
+	defer ml.Unlock()
+
+	looper = scheduler.Loop(func(float64) {
+		once.Do(func() {
+			ml.Lock()
+			ended := looper
+			ml.Unlock()
+
+			ended.End()
+			fn()
+		})
+	})
+}
+
+//==============================================================================